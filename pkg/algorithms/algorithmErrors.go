@@ -0,0 +1,14 @@
+package algorithms
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+var (
+	InvalidVertex  = graph.GraphError("Vertex does not belong to the graph")
+	SourceIsSink   = graph.GraphError("Source and sink are the same vertex")
+	SameVertices   = graph.GraphError("Vertices are expected to be different")
+	NotAcyclic     = graph.GraphError("Digraph contains a directed cycle")
+	IsolatedVertex = graph.GraphError("Graph has an isolated vertex")
+	GraphTooLarge  = graph.GraphError("Graph is too large for an exact search")
+)
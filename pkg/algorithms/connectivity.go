@@ -0,0 +1,68 @@
+package algorithms
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Checks that u and v are two different vertices of a graph of order n.
+func checkVertexPair(n, u, v int) error {
+	if u < 0 || u >= n || v < 0 || v >= n {
+		return InvalidVertex
+	}
+	if u == v {
+		return SameVertices
+	}
+	return nil
+}
+
+// LocalEdgeConnectivity returns the maximum number of edge-disjoint paths
+// between two different vertices u and v of a graph which, by Menger's
+// theorem, equals the minimum number of edges whose removal separates u from
+// v. Parallel edges are counted with their multiplicity.
+func LocalEdgeConnectivity(g *StaticGraph, u, v int) (int, error) {
+	matrix := graph.MatrixOf(g)
+	if err := checkVertexPair(len(matrix), u, v); err != nil {
+		return 0, err
+	}
+	flow, err := MaxFlowDinic(graph.NewDigraphFromMatrix(matrix), u, v)
+	if err != nil {
+		return 0, err
+	}
+	return flow.Value, nil
+}
+
+// LocalVertexConnectivity returns the maximum number of internally disjoint
+// paths between two different vertices u and v of a graph. By Menger's
+// theorem, if u and v are not adjacent, this equals the minimum number of
+// vertices whose removal separates u from v. If they are adjacent, each edge
+// between them counts as one of the paths.
+func LocalVertexConnectivity(g *StaticGraph, u, v int) (int, error) {
+	matrix := graph.MatrixOf(g)
+	n := len(matrix)
+	if err := checkVertexPair(n, u, v); err != nil {
+		return 0, err
+	}
+
+	// Every vertex x is split into an in-vertex 2x and an out-vertex 2x+1,
+	// joined by an arc of capacity one. Every edge xy, other than those
+	// joining u and v, becomes the arcs from the out-vertex of each end to the
+	// in-vertex of the other.
+	split := make([][]byte, 2*n)
+	for i := range split {
+		split[i] = make([]byte, 2*n)
+	}
+	for x := 0; x < n; x++ {
+		split[2*x][2*x+1] = 1
+		for y := 0; y < n; y++ {
+			if x != y && matrix[x][y] != 0 && !(x == u && y == v) &&
+				!(x == v && y == u) {
+				split[2*x+1][2*y] = 1
+			}
+		}
+	}
+	flow, err := MaxFlowDinic(graph.NewDigraphFromMatrix(split), 2*u+1, 2*v)
+	if err != nil {
+		return 0, err
+	}
+	return flow.Value + int(matrix[u][v]), nil
+}
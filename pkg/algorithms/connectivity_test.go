@@ -0,0 +1,58 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// TestLocalConnectivity computes the local connectivities between every pair
+// of vertices of the Petersen graph, which is 3-connected and 3-regular, and
+// between two vertices of a bowtie (two triangles sharing a vertex).
+func TestLocalConnectivity(t *testing.T) {
+	matrix := [][]byte{
+		{0, 1, 0, 0, 1, 1, 0, 0, 0, 0},
+		{1, 0, 1, 0, 0, 0, 1, 0, 0, 0},
+		{0, 1, 0, 1, 0, 0, 0, 1, 0, 0},
+		{0, 0, 1, 0, 1, 0, 0, 0, 1, 0},
+		{1, 0, 0, 1, 0, 0, 0, 0, 0, 1},
+		{1, 0, 0, 0, 0, 0, 0, 1, 1, 0},
+		{0, 1, 0, 0, 0, 0, 0, 0, 1, 1},
+		{0, 0, 1, 0, 0, 1, 0, 0, 0, 1},
+		{0, 0, 0, 1, 0, 1, 1, 0, 0, 0},
+		{0, 0, 0, 0, 1, 0, 1, 1, 0, 0},
+	}
+	petersen := graph.NewFromMatrix(matrix)
+	for u := 0; u < 10; u++ {
+		for v := u + 1; v < 10; v++ {
+			if k, _ := LocalVertexConnectivity(petersen, u, v); k != 3 {
+				t.Errorf("Between %v and %v expected %v, got %v", u, v, 3, k)
+			}
+			if k, _ := LocalEdgeConnectivity(petersen, u, v); k != 3 {
+				t.Errorf("Between %v and %v expected %v, got %v", u, v, 3, k)
+			}
+		}
+	}
+	bowtie := graph.NewFromList([][]int{
+		{1, 2},
+		{0, 2},
+		{0, 1, 3, 4},
+		{2, 4},
+		{2, 3},
+	})
+	if k, _ := LocalVertexConnectivity(bowtie, 0, 3); k != 1 {
+		t.Errorf("Expected %v, got %v", 1, k)
+	}
+	if k, _ := LocalEdgeConnectivity(bowtie, 0, 3); k != 2 {
+		t.Errorf("Expected %v, got %v", 2, k)
+	}
+	if k, _ := LocalVertexConnectivity(bowtie, 0, 2); k != 2 {
+		t.Errorf("Expected %v, got %v", 2, k)
+	}
+	if _, err := LocalVertexConnectivity(bowtie, 1, 1); err != SameVertices {
+		t.Errorf("Expected %v, got %v", SameVertices, err)
+	}
+	if _, err := LocalEdgeConnectivity(bowtie, -1, 1); err != InvalidVertex {
+		t.Errorf("Expected %v, got %v", InvalidVertex, err)
+	}
+}
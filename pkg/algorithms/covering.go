@@ -0,0 +1,377 @@
+package algorithms
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// The solvers in this file are exact branch and bound searches, so they take
+// exponential time and are intended for small graphs. Multiple edges are
+// ignored, and so are loops, except by the vertex cover and feedback vertex set
+// solvers, which take every vertex with a loop. Every set is returned in
+// increasing order.
+
+// Returns the adjacency relation of the simple graph underlying a graph.
+func simpleAdjacency(g *StaticGraph) [][]bool {
+	matrix := graph.MatrixOf(g)
+	adj := make([][]bool, len(matrix))
+	for i := range matrix {
+		adj[i] = make([]bool, len(matrix))
+		for j := range matrix {
+			adj[i][j] = i != j && (matrix[i][j] != 0 || matrix[j][i] != 0)
+		}
+	}
+	return adj
+}
+
+// Returns which vertices of a graph have a loop.
+func loopedVertices(g *StaticGraph) []bool {
+	matrix := graph.MatrixOf(g)
+	looped := make([]bool, len(matrix))
+	for v := range matrix {
+		looped[v] = matrix[v][v] != 0
+	}
+	return looped
+}
+
+// Makes the adjacency matrix of an adjacency relation.
+func toMatrix(adj [][]bool) graph.AdjacencyMatrix {
+	a := make([][]byte, len(adj))
+	for i := range adj {
+		a[i] = make([]byte, len(adj))
+		for j, b := range adj[i] {
+			if b {
+				a[i][j] = 1
+			}
+		}
+	}
+	return a
+}
+
+// Returns the maximum degree of an adjacency relation.
+func maximumDegree(adj [][]bool) int {
+	max := 0
+	for _, row := range adj {
+		d := 0
+		for _, b := range row {
+			if b {
+				d++
+			}
+		}
+		if d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+// Returns the vertices marked in a slice, in increasing order.
+func markedVertices(marked []bool) []int {
+	vertices := make([]int, 0)
+	for v, b := range marked {
+		if b {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// Finds a smallest set of vertices such that every vertex is dominated, where
+// candidates(u) returns the vertices that may dominate an undominated vertex
+// u, and closed tells whether a vertex dominates itself. Every vertex
+// dominates at most maxDegree + 1 vertices, which bounds the search.
+func dominationSearch(adj [][]bool, closed bool, candidates func(u int, dominated []int) []int) []int {
+	n := len(adj)
+	reach := maximumDegree(adj)
+	if closed {
+		reach++
+	}
+	if reach == 0 {
+		reach = 1
+	}
+	// dominated[v] counts the chosen vertices dominating v.
+	dominated := make([]int, n)
+	chosen := make([]bool, n)
+	var best []int
+	var search func(size, undominated int)
+	search = func(size, undominated int) {
+		if undominated == 0 {
+			if best == nil || size < len(best) {
+				best = markedVertices(chosen)
+			}
+			return
+		}
+		if best != nil && size+(undominated+reach-1)/reach >= len(best) {
+			return
+		}
+		u := 0
+		for dominated[u] > 0 {
+			u++
+		}
+		for _, w := range candidates(u, dominated) {
+			count := 0
+			chosen[w] = true
+			for x := 0; x < n; x++ {
+				if adj[w][x] || (closed && x == w) {
+					if dominated[x] == 0 {
+						count++
+					}
+					dominated[x]++
+				}
+			}
+			search(size+1, undominated-count)
+			for x := 0; x < n; x++ {
+				if adj[w][x] || (closed && x == w) {
+					dominated[x]--
+				}
+			}
+			chosen[w] = false
+		}
+	}
+	search(0, n)
+	return best
+}
+
+// MinimumDominatingSet returns a smallest dominating set of a graph, a set of
+// vertices such that every vertex is in the set or adjacent to a vertex in it.
+func MinimumDominatingSet(g *StaticGraph) []int {
+	adj := simpleAdjacency(g)
+	return dominationSearch(adj, true, func(u int, _ []int) []int {
+		candidates := []int{u}
+		for w, b := range adj[u] {
+			if b {
+				candidates = append(candidates, w)
+			}
+		}
+		return candidates
+	})
+}
+
+// MinimumTotalDominatingSet returns a smallest total dominating set of a
+// graph, a set of vertices such that every vertex is adjacent to a vertex in
+// the set. If the graph has an isolated vertex, there is no such set and an
+// error is returned.
+func MinimumTotalDominatingSet(g *StaticGraph) ([]int, error) {
+	adj := simpleAdjacency(g)
+	var neighbours [][]int
+	for u := range adj {
+		var candidates []int
+		for w, b := range adj[u] {
+			if b {
+				candidates = append(candidates, w)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, IsolatedVertex
+		}
+		neighbours = append(neighbours, candidates)
+	}
+	return dominationSearch(adj, false, func(u int, _ []int) []int {
+		return neighbours[u]
+	}), nil
+}
+
+// MinimumIndependentDominatingSet returns a smallest independent dominating
+// set of a graph, a set of pairwise non-adjacent vertices such that every
+// vertex is in the set or adjacent to a vertex in it. Its order is the
+// independent domination number.
+func MinimumIndependentDominatingSet(g *StaticGraph) []int {
+	adj := simpleAdjacency(g)
+	// A vertex may only be added if it is not dominated yet, since otherwise
+	// it is adjacent to a chosen vertex.
+	return dominationSearch(adj, true, func(u int, dominated []int) []int {
+		candidates := []int{u}
+		for w, b := range adj[u] {
+			if b && dominated[w] == 0 {
+				candidates = append(candidates, w)
+			}
+		}
+		return candidates
+	})
+}
+
+// Returns a largest independent set of an adjacency relation among the
+// vertices that are still available, using the recurrence
+// α(G) = max(α(G - v), 1 + α(G - N[v])) on a vertex v of maximum degree.
+// Vertices of degree at most one are always taken.
+func maximumIndependentSet(adj [][]bool, available []bool) []int {
+	n := len(adj)
+	degree := func(v int) int {
+		d := 0
+		for w, b := range adj[v] {
+			if b && available[w] {
+				d++
+			}
+		}
+		return d
+	}
+	take := func(v int) []bool {
+		rest := append([]bool(nil), available...)
+		rest[v] = false
+		for w, b := range adj[v] {
+			if b {
+				rest[w] = false
+			}
+		}
+		return rest
+	}
+	v, max := -1, -1
+	for u := 0; u < n; u++ {
+		if !available[u] {
+			continue
+		}
+		d := degree(u)
+		if d <= 1 {
+			return append([]int{u}, maximumIndependentSet(adj, take(u))...)
+		}
+		if d > max {
+			v, max = u, d
+		}
+	}
+	if v == -1 {
+		return []int{}
+	}
+	with := append([]int{v}, maximumIndependentSet(adj, take(v))...)
+	rest := append([]bool(nil), available...)
+	rest[v] = false
+	without := maximumIndependentSet(adj, rest)
+	if len(without) > len(with) {
+		return without
+	}
+	return with
+}
+
+// MaximumIndependentSet returns a largest independent set of a graph, a set of
+// pairwise non-adjacent vertices.
+func MaximumIndependentSet(g *StaticGraph) []int {
+	adj := simpleAdjacency(g)
+	available := make([]bool, len(adj))
+	for v := range available {
+		available[v] = true
+	}
+	marked := make([]bool, len(adj))
+	for _, v := range maximumIndependentSet(adj, available) {
+		marked[v] = true
+	}
+	return markedVertices(marked)
+}
+
+// MinimumVertexCover returns a smallest vertex cover of a graph, a set of
+// vertices containing an endpoint of every edge, which is the complement of a
+// largest independent set. A vertex with a loop covers it, so every such
+// vertex is in the cover.
+func MinimumVertexCover(g *StaticGraph) []int {
+	adj := simpleAdjacency(g)
+	looped := loopedVertices(g)
+	available := make([]bool, len(adj))
+	cover := make([]bool, len(adj))
+	for v := range cover {
+		available[v], cover[v] = !looped[v], true
+	}
+	for _, v := range maximumIndependentSet(adj, available) {
+		cover[v] = false
+	}
+	return markedVertices(cover)
+}
+
+// Returns a shortest cycle among the vertices that are still available, as a
+// sequence of distinct vertices where consecutive vertices, and the last and
+// the first ones, are adjacent, or nil if they induce a forest.
+func shortestCycle(adj [][]bool, available []bool) []int {
+	var best []int
+	for s := range adj {
+		if !available[s] {
+			continue
+		}
+		distance := make([]int, len(adj))
+		parent := make([]int, len(adj))
+		for v := range distance {
+			distance[v] = -1
+		}
+		distance[s], parent[s] = 0, -1
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for w, b := range adj[v] {
+				if !b || !available[w] || w == parent[v] {
+					continue
+				}
+				if distance[w] == -1 {
+					distance[w], parent[w] = distance[v]+1, v
+					queue = append(queue, w)
+				} else if cycle := closeCycle(parent, v, w); best == nil || len(cycle) < len(best) {
+					best = cycle
+				}
+			}
+		}
+	}
+	return best
+}
+
+// Returns the cycle closed by an edge vw outside a breadth-first search tree,
+// given by the parent of every vertex: the paths from v and from w up to
+// their last common vertex, joined by the edge.
+func closeCycle(parent []int, v, w int) []int {
+	position := make(map[int]int)
+	var path []int
+	for x := v; x != -1; x = parent[x] {
+		position[x] = len(path)
+		path = append(path, x)
+	}
+	var other []int
+	x := w
+	for ; ; x = parent[x] {
+		if _, ok := position[x]; ok {
+			break
+		}
+		other = append(other, x)
+	}
+	cycle := path[:position[x]+1]
+	for i := len(other) - 1; i >= 0; i-- {
+		cycle = append(cycle, other[i])
+	}
+	return cycle
+}
+
+// MinimumFeedbackVertexSet returns a smallest feedback vertex set of a graph,
+// a set of vertices whose removal leaves a forest. Since some vertex of every
+// cycle must be removed, the search branches on the vertices of a shortest
+// cycle, for increasing bounds on the size of the set. A loop is a cycle, so
+// every vertex with a loop is in the set.
+func MinimumFeedbackVertexSet(g *StaticGraph) []int {
+	adj := simpleAdjacency(g)
+	looped := loopedVertices(g)
+	available := make([]bool, len(adj))
+	for v := range available {
+		available[v] = !looped[v]
+	}
+	var search func(k int) bool
+	search = func(k int) bool {
+		cycle := shortestCycle(adj, available)
+		if cycle == nil {
+			return true
+		} else if k == 0 {
+			return false
+		}
+		for _, v := range cycle {
+			if !available[v] {
+				continue
+			}
+			available[v] = false
+			if search(k - 1) {
+				return true
+			}
+			available[v] = true
+		}
+		return false
+	}
+	for k := 0; ; k++ {
+		if search(k) {
+			removed := make([]bool, len(adj))
+			for v, b := range available {
+				removed[v] = !b
+			}
+			return markedVertices(removed)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns the order of a smallest subset of vertices satisfying a predicate,
+// found by inspecting every subset, or -1 if there is none.
+func bruteForceMinimum(n int, valid func([]int) bool) int {
+	best := -1
+	for mask := 0; mask < 1<<uint(n); mask++ {
+		var vertices []int
+		for v := 0; v < n; v++ {
+			if mask&(1<<uint(v)) != 0 {
+				vertices = append(vertices, v)
+			}
+		}
+		if (best == -1 || len(vertices) < best) && valid(vertices) {
+			best = len(vertices)
+		}
+	}
+	return best
+}
+
+// TestCoveringRandom compares the solvers with a brute force search on random
+// graphs, and checks their sets with the verifiers.
+func TestCoveringRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for k := 0; k < 40; k++ {
+		n := 1 + r.Intn(9)
+		g, _ := generators.GnpRandomGraph(n, r.Float64(), r)
+		checks := []struct {
+			name   string
+			set    []int
+			verify func(generators.Graph, []int) bool
+		}{
+			{"dominating", MinimumDominatingSet(g), generators.IsDominatingSet},
+			{"independent dominating", MinimumIndependentDominatingSet(g),
+				generators.IsIndependentDominatingSet},
+			{"vertex cover", MinimumVertexCover(g), generators.IsVertexCover},
+			{"feedback vertex", MinimumFeedbackVertexSet(g), generators.IsFeedbackVertexSet},
+		}
+		total, err := MinimumTotalDominatingSet(g)
+		if err == nil {
+			checks = append(checks, struct {
+				name   string
+				set    []int
+				verify func(generators.Graph, []int) bool
+			}{"total dominating", total, generators.IsTotalDominatingSet})
+		} else if bruteForceMinimum(n, func(s []int) bool {
+			return generators.IsTotalDominatingSet(g, s)
+		}) != -1 {
+			t.Errorf("Unexpected %v", err)
+		}
+		for _, c := range checks {
+			if !c.verify(g, c.set) {
+				t.Errorf("Set %v is not a %v set", c.set, c.name)
+			}
+			expected := bruteForceMinimum(n, func(s []int) bool { return c.verify(g, s) })
+			if len(c.set) != expected {
+				t.Errorf("Expected a %v set of order %v, got %v", c.name, expected, c.set)
+			}
+		}
+		independent := MaximumIndependentSet(g)
+		if !generators.IsStable(g, independent) ||
+			len(independent)+len(MinimumVertexCover(g)) != n {
+			t.Errorf("Expected a maximum independent set, got %v", independent)
+		}
+	}
+}
+
+// TestCoveringWithLoops checks the vertex covers and feedback vertex sets of
+// graphs with loops with the verifiers, which count loops as edges and cycles.
+func TestCoveringWithLoops(t *testing.T) {
+	graphs := []*StaticGraph{
+		graph.NewFromMatrix([][]byte{{1, 1}, {1, 0}}),
+		graph.NewFromMatrix([][]byte{{1, 1, 0, 0}, {1, 0, 1, 1}, {0, 1, 0, 1}, {0, 1, 1, 1}}),
+		graph.NewFromList([][]int{{0, 1}, {0, 2}, {1, 2}}),
+	}
+	for _, g := range graphs {
+		n := g.Order()
+		checks := []struct {
+			name   string
+			set    []int
+			verify func(generators.Graph, []int) bool
+		}{
+			{"vertex cover", MinimumVertexCover(g), generators.IsVertexCover},
+			{"feedback vertex", MinimumFeedbackVertexSet(g), generators.IsFeedbackVertexSet},
+		}
+		for _, c := range checks {
+			if !c.verify(g, c.set) {
+				t.Errorf("Set %v is not a %v set", c.set, c.name)
+			}
+			expected := bruteForceMinimum(n, func(s []int) bool { return c.verify(g, s) })
+			if len(c.set) != expected {
+				t.Errorf("Expected a %v set of order %v, got %v", c.name, expected, c.set)
+			}
+		}
+	}
+}
+
+// TestShortestCycle checks that the shortest cycles found are sequences of
+// distinct vertices closing a cycle, on a triangle hanging from a path and on
+// random graphs.
+func TestShortestCycle(t *testing.T) {
+	hanging := simpleAdjacency(graph.NewFromList([][]int{{1}, {0, 2, 3}, {1, 3}, {1, 2}}))
+	r := rand.New(rand.NewSource(42))
+	for k := 0; k < 40; k++ {
+		adj := hanging
+		if k > 0 {
+			random, _ := generators.GnpRandomGraph(1+r.Intn(9), r.Float64(), r)
+			adj = simpleAdjacency(random)
+		}
+		available := make([]bool, len(adj))
+		for v := range available {
+			available[v] = true
+		}
+		cycle := shortestCycle(adj, available)
+		if cycle == nil {
+			if forest := generators.IsFeedbackVertexSet(graph.NewFromMatrix(toMatrix(adj)), nil); !forest {
+				t.Errorf("Expected a cycle in %v", adj)
+			}
+			continue
+		}
+		seen := make(map[int]bool)
+		for i, v := range cycle {
+			if seen[v] || !adj[v][cycle[(i+1)%len(cycle)]] {
+				t.Errorf("Expected a cycle, got %v", cycle)
+				break
+			}
+			seen[v] = true
+		}
+		if len(cycle) < 3 || (k == 0 && len(cycle) != 3) {
+			t.Errorf("Expected a shorter cycle than %v", cycle)
+		}
+	}
+}
+
+// TestCoveringKnownValues checks the solvers on the Petersen graph and small
+// complete graphs, and that total domination is rejected with isolated
+// vertices.
+func TestCoveringKnownValues(t *testing.T) {
+	petersen := generators.PetersenListGraph()
+	tests := []struct {
+		name     string
+		set      []int
+		expected int
+	}{
+		{"dominating", MinimumDominatingSet(petersen), 3},
+		{"independent dominating", MinimumIndependentDominatingSet(petersen), 3},
+		{"vertex cover", MinimumVertexCover(petersen), 6},
+		{"feedback vertex", MinimumFeedbackVertexSet(petersen), 3},
+		{"independent", MaximumIndependentSet(petersen), 4},
+		{"feedback vertex", MinimumFeedbackVertexSet(generators.CompleteMatrixGraph(4)), 2},
+		{"vertex cover", MinimumVertexCover(generators.CompleteMatrixGraph(5)), 4},
+	}
+	for _, test := range tests {
+		if len(test.set) != test.expected {
+			t.Errorf("Expected a %v set of order %v, got %v", test.name, test.expected, test.set)
+		}
+	}
+	if s, _ := MinimumTotalDominatingSet(petersen); len(s) != 4 {
+		t.Errorf("Expected a total dominating set of order %v, got %v", 4, s)
+	}
+	g := graph.NewFromList([][]int{{1}, {0}, {}})
+	if _, err := MinimumTotalDominatingSet(g); err != IsolatedVertex {
+		t.Errorf("Expected %v, got %v", IsolatedVertex, err)
+	}
+}
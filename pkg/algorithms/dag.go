@@ -0,0 +1,290 @@
+package algorithms
+
+import (
+	"sort"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// IsAcyclic checks whether a digraph has no directed cycles. If the digraph
+// is not acyclic, a directed cycle is returned as a certificate: a sequence of
+// vertices v0, ..., vk such that there is an arc from each vertex to the next
+// one, and an arc from vk to v0. A loop is a directed cycle of length one.
+func IsAcyclic(d *StaticDigraph) (bool, []int) {
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+
+	// A vertex is white (0) if it has not been visited, grey (1) if it is in
+	// the current branch of the search, and black (2) if it has been finished.
+	colour := make([]int, n)
+	parent := make([]int, n)
+	var cycle []int
+	var visit func(u int) bool
+	visit = func(u int) bool {
+		colour[u] = 1
+		for v := 0; v < n; v++ {
+			if matrix[u][v] == 0 {
+				continue
+			}
+			if colour[v] == 1 {
+				// Closing arc of a directed cycle, recover it from the branch.
+				cycle = []int{u}
+				for w := u; w != v; {
+					w = parent[w]
+					cycle = append(cycle, w)
+				}
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return true
+			} else if colour[v] == 0 {
+				parent[v] = u
+				if visit(v) {
+					return true
+				}
+			}
+		}
+		colour[u] = 2
+		return false
+	}
+	for v := 0; v < n; v++ {
+		if colour[v] == 0 && visit(v) {
+			return false, cycle
+		}
+	}
+	return true, nil
+}
+
+// TopologicalSort returns an ordering of the vertices of an acyclic digraph in
+// which every arc goes from a vertex to a later one. Among all such orderings,
+// the lexicographically smallest one is returned. If the digraph has a
+// directed cycle, an error is returned.
+func TopologicalSort(d *StaticDigraph) ([]int, error) {
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+	indegree := make([]int, n)
+	for u := range matrix {
+		for v, a := range matrix[u] {
+			if a != 0 {
+				indegree[v]++
+			}
+		}
+	}
+	var sources []int
+	for v, k := range indegree {
+		if k == 0 {
+			sources = append(sources, v)
+		}
+	}
+	order := make([]int, 0, n)
+	for len(sources) > 0 {
+		sort.Ints(sources)
+		u := sources[0]
+		sources = sources[1:]
+		order = append(order, u)
+		for v, a := range matrix[u] {
+			if a != 0 {
+				indegree[v]--
+				if indegree[v] == 0 {
+					sources = append(sources, v)
+				}
+			}
+		}
+	}
+	if len(order) != n {
+		return nil, NotAcyclic
+	}
+	return order, nil
+}
+
+// LongestPath returns the vertices of a longest directed path in an acyclic
+// digraph, in the order they are traversed. The length of a path is its
+// number of arcs. If the digraph has a directed cycle, an error is returned.
+func LongestPath(d *StaticDigraph) ([]int, error) {
+	order, err := TopologicalSort(d)
+	if err != nil {
+		return nil, err
+	}
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+	if n == 0 {
+		return []int{}, nil
+	}
+
+	// length[v] is the length of a longest path ending at v, and previous[v]
+	// is the vertex before v in such a path.
+	length := make([]int, n)
+	previous := make([]int, n)
+	for i := range previous {
+		previous[i] = -1
+	}
+	last := order[0]
+	for _, u := range order {
+		for v, a := range matrix[u] {
+			if a != 0 && length[u]+1 > length[v] {
+				length[v] = length[u] + 1
+				previous[v] = u
+			}
+		}
+		if length[u] > length[last] {
+			last = u
+		}
+	}
+	path := []int{}
+	for v := last; v != -1; v = previous[v] {
+		path = append([]int{v}, path...)
+	}
+	return path, nil
+}
+
+// TransitiveClosure returns the transitive closure of a digraph: the digraph
+// on the same vertices with an arc from u to v whenever there is a directed
+// walk of positive length from u to v. Hence, a vertex has a loop in the
+// closure if and only if it lies in a directed cycle.
+func TransitiveClosure(d *StaticDigraph) *StaticDigraph {
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+	closure := make([][]byte, n)
+	for i := range matrix {
+		closure[i] = make([]byte, n)
+		for j, a := range matrix[i] {
+			if a != 0 {
+				closure[i][j] = 1
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if closure[i][k] == 0 {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if closure[k][j] != 0 {
+					closure[i][j] = 1
+				}
+			}
+		}
+	}
+	return graph.NewDigraphFromMatrix(closure)
+}
+
+// TransitiveReduction returns the transitive reduction of an acyclic digraph:
+// the unique digraph with the fewest arcs having the same transitive closure.
+// An arc uv is kept if and only if there is no other directed path from u to
+// v. If the digraph has a directed cycle, an error is returned.
+func TransitiveReduction(d *StaticDigraph) (*StaticDigraph, error) {
+	if ok, _ := IsAcyclic(d); !ok {
+		return nil, NotAcyclic
+	}
+	matrix := graph.MatrixOf(d)
+	closure, _ := TransitiveClosure(d).Matrix()
+	n := len(matrix)
+	reduction := make([][]byte, n)
+	for u := range matrix {
+		reduction[u] = make([]byte, n)
+		for v, a := range matrix[u] {
+			if a == 0 {
+				continue
+			}
+			reduction[u][v] = 1
+			for w := 0; w < n; w++ {
+				if w != v && matrix[u][w] != 0 && closure[w][v] != 0 {
+					reduction[u][v] = 0
+					break
+				}
+			}
+		}
+	}
+	return graph.NewDigraphFromMatrix(reduction), nil
+}
+
+// StronglyConnectedComponents returns the strong components of a digraph,
+// computed with Tarjan's algorithm. Each component is sorted in increasing
+// order, and the components are listed in a topological order of the
+// condensation: no arc goes from a component to a previous one.
+func StronglyConnectedComponents(d *StaticDigraph) [][]int {
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+	index := make([]int, n)
+	lowlink := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range index {
+		index[i] = -1
+	}
+	var stack []int
+	var components [][]int
+	counter := 0
+	var connect func(u int)
+	connect = func(u int) {
+		index[u] = counter
+		lowlink[u] = counter
+		counter++
+		stack = append(stack, u)
+		onStack[u] = true
+		for v := 0; v < n; v++ {
+			if matrix[u][v] == 0 {
+				continue
+			}
+			if index[v] == -1 {
+				connect(v)
+				if lowlink[v] < lowlink[u] {
+					lowlink[u] = lowlink[v]
+				}
+			} else if onStack[v] && index[v] < lowlink[u] {
+				lowlink[u] = index[v]
+			}
+		}
+		if lowlink[u] == index[u] {
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == u {
+					break
+				}
+			}
+			sort.Ints(component)
+			components = append(components, component)
+		}
+	}
+	for v := 0; v < n; v++ {
+		if index[v] == -1 {
+			connect(v)
+		}
+	}
+	// Tarjan's algorithm finds the components in reverse topological order.
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+	return components
+}
+
+// Condensation returns the condensation of a digraph, the acyclic digraph
+// obtained by contracting each strong component into a single vertex, along
+// with the vertex of the condensation each vertex of the digraph belongs to.
+// Vertices of the condensation follow the order of
+// StronglyConnectedComponents, so they are topologically sorted.
+func Condensation(d *StaticDigraph) (*StaticDigraph, []int) {
+	matrix := graph.MatrixOf(d)
+	components := StronglyConnectedComponents(d)
+	component := make([]int, len(matrix))
+	for i, c := range components {
+		for _, v := range c {
+			component[v] = i
+		}
+	}
+	condensation := make([][]byte, len(components))
+	for i := range condensation {
+		condensation[i] = make([]byte, len(components))
+	}
+	for u := range matrix {
+		for v, a := range matrix[u] {
+			if a != 0 && component[u] != component[v] {
+				condensation[component[u]][component[v]] = 1
+			}
+		}
+	}
+	return graph.NewDigraphFromMatrix(condensation), component
+}
@@ -0,0 +1,179 @@
+package algorithms
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Checks that a sequence of vertices is a directed cycle of a digraph.
+func isDirectedCycle(matrix [][]byte, cycle []int) bool {
+	if len(cycle) == 0 {
+		return false
+	}
+	for i, u := range cycle {
+		if matrix[u][cycle[(i+1)%len(cycle)]] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestIsAcyclic checks an acyclic digraph, a digraph with a directed cycle of
+// length three, and a digraph with a loop.
+func TestIsAcyclic(t *testing.T) {
+	a := [][]byte{
+		{0, 1, 1, 0},
+		{0, 0, 1, 1},
+		{0, 0, 0, 1},
+		{0, 0, 0, 0},
+	}
+	b := [][]byte{
+		{0, 1, 0, 0, 0},
+		{0, 0, 1, 0, 0},
+		{0, 0, 0, 1, 0},
+		{0, 1, 0, 0, 1},
+		{0, 0, 0, 0, 0},
+	}
+	c := [][]byte{
+		{0, 1},
+		{0, 1},
+	}
+	if ok, cycle := IsAcyclic(graph.NewDigraphFromMatrix(a)); !ok || cycle != nil {
+		t.Errorf("Expected %v, got %v with cycle %v", true, ok, cycle)
+	}
+	for _, m := range [][][]byte{b, c} {
+		ok, cycle := IsAcyclic(graph.NewDigraphFromMatrix(m))
+		if ok {
+			t.Errorf("Expected %v, got %v", false, ok)
+		}
+		if !isDirectedCycle(m, cycle) {
+			t.Errorf("%v is not a directed cycle", cycle)
+		}
+	}
+}
+
+// TestTopologicalSort sorts a small acyclic digraph, and checks that an error
+// is returned for a directed cycle.
+func TestTopologicalSort(t *testing.T) {
+	m := [][]byte{
+		{0, 0, 0, 0, 0},
+		{0, 0, 0, 1, 0},
+		{1, 0, 0, 0, 1},
+		{1, 0, 0, 0, 0},
+		{0, 0, 0, 1, 0},
+	}
+	got, err := TopologicalSort(graph.NewDigraphFromMatrix(m))
+	if err != nil {
+		t.Errorf("Didn't expect an error, got %v", err)
+	}
+	if want := []int{1, 2, 4, 3, 0}; !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	cycle := graph.NewDigraphFromList([][]int{{1}, {2}, {0}})
+	if _, err := TopologicalSort(cycle); err != NotAcyclic {
+		t.Errorf("Expected %v, got %v", NotAcyclic, err)
+	}
+}
+
+// TestLongestPath computes the longest path of an acyclic digraph.
+func TestLongestPath(t *testing.T) {
+	m := [][]byte{
+		{0, 1, 0, 0, 0, 1},
+		{0, 0, 1, 0, 0, 0},
+		{0, 0, 0, 0, 1, 0},
+		{0, 0, 1, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 1, 0, 0},
+	}
+	got, _ := LongestPath(graph.NewDigraphFromMatrix(m))
+	if want := []int{0, 5, 3, 2, 4}; !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	cycle := graph.NewDigraphFromList([][]int{{1}, {0}})
+	if _, err := LongestPath(cycle); err != NotAcyclic {
+		t.Errorf("Expected %v, got %v", NotAcyclic, err)
+	}
+}
+
+// TestTransitiveClosureAndReduction computes the transitive closure and
+// reduction of the digraph of the divisibility order on {1, 2, 3, 4, 6, 12}.
+func TestTransitiveClosureAndReduction(t *testing.T) {
+	divisors := []int{1, 2, 3, 4, 6, 12}
+	closure := make([][]byte, len(divisors))
+	reduction := make([][]byte, len(divisors))
+	for i, a := range divisors {
+		closure[i] = make([]byte, len(divisors))
+		reduction[i] = make([]byte, len(divisors))
+		for j, b := range divisors {
+			if a != b && b%a == 0 {
+				closure[i][j] = 1
+				if p := b / a; p == 2 || p == 3 {
+					reduction[i][j] = 1
+				}
+			}
+		}
+	}
+	d := graph.NewDigraphFromMatrix(closure)
+	got, err := TransitiveReduction(d)
+	if err != nil {
+		t.Errorf("Didn't expect an error, got %v", err)
+	}
+	gotMatrix, _ := got.Matrix()
+	if !reflect.DeepEqual(reduction, gotMatrix) {
+		t.Errorf("Expected %v, got %v", reduction, gotMatrix)
+	}
+	gotMatrix, _ = TransitiveClosure(got).Matrix()
+	if !reflect.DeepEqual(closure, gotMatrix) {
+		t.Errorf("Expected %v, got %v", closure, gotMatrix)
+	}
+
+	// The closure of a directed cycle is the complete digraph with loops.
+	cycle := graph.NewDigraphFromList([][]int{{1}, {2}, {0}})
+	gotMatrix, _ = TransitiveClosure(cycle).Matrix()
+	want := [][]byte{{1, 1, 1}, {1, 1, 1}, {1, 1, 1}}
+	if !reflect.DeepEqual(want, gotMatrix) {
+		t.Errorf("Expected %v, got %v", want, gotMatrix)
+	}
+	if _, err := TransitiveReduction(cycle); err != NotAcyclic {
+		t.Errorf("Expected %v, got %v", NotAcyclic, err)
+	}
+}
+
+// TestCondensation computes the strong components and condensation of a
+// digraph with three non-trivial strong components.
+func TestCondensation(t *testing.T) {
+	m := [][]byte{
+		{0, 1, 0, 0, 0, 0, 0, 0},
+		{0, 0, 1, 0, 1, 1, 0, 0},
+		{0, 0, 0, 1, 0, 0, 1, 0},
+		{0, 0, 1, 0, 0, 0, 0, 1},
+		{1, 0, 0, 0, 0, 1, 0, 0},
+		{0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 1, 0, 0},
+		{0, 0, 0, 1, 0, 0, 1, 0},
+	}
+	d := graph.NewDigraphFromMatrix(m)
+	components := StronglyConnectedComponents(d)
+	want := [][]int{{0, 1, 4}, {2, 3, 7}, {5, 6}}
+	if !reflect.DeepEqual(want, components) {
+		t.Errorf("Expected %v, got %v", want, components)
+	}
+	condensation, component := Condensation(d)
+	if want := []int{0, 0, 1, 1, 0, 2, 2, 1}; !reflect.DeepEqual(want, component) {
+		t.Errorf("Expected %v, got %v", want, component)
+	}
+	gotMatrix, _ := condensation.Matrix()
+	wantMatrix := [][]byte{
+		{0, 1, 1},
+		{0, 0, 1},
+		{0, 0, 0},
+	}
+	if !reflect.DeepEqual(wantMatrix, gotMatrix) {
+		t.Errorf("Expected %v, got %v", wantMatrix, gotMatrix)
+	}
+	if ok, _ := IsAcyclic(condensation); !ok {
+		t.Errorf("The condensation is expected to be acyclic")
+	}
+}
@@ -0,0 +1,222 @@
+package algorithms
+
+// Edge colourings are modelled by matrices of colours: colours[u][v] is the
+// colour of the edge uv, where the colours are 0, 1, 2, ..., and it is -1 when
+// u and v are not adjacent. Graphs are considered simple: loops and multiple
+// edges are ignored.
+
+// Returns a matrix of colours without coloured edges.
+func uncolouredEdges(n int) [][]int {
+	colours := make([][]int, n)
+	for u := range colours {
+		colours[u] = make([]int, n)
+		for v := range colours[u] {
+			colours[u][v] = -1
+		}
+	}
+	return colours
+}
+
+// Returns whether no edge at a vertex has a given colour.
+func freeColour(colours [][]int, v, c int) bool {
+	for _, d := range colours[v] {
+		if d == c {
+			return false
+		}
+	}
+	return true
+}
+
+// Colours the edge uv with one of the colours 0, ..., Δ, in the manner of
+// Misra and Gries, assuming the rest of the edges use those colours properly.
+func misraGriesStep(adj [][]bool, colours [][]int, u, v, colourCount int) {
+	free := func(x int) int {
+		for c := 0; c < colourCount; c++ {
+			if freeColour(colours, x, c) {
+				return c
+			}
+		}
+		return -1
+	}
+	// A maximal fan of u starting at v, where every edge from u to a vertex of
+	// the fan has a colour that is free at the previous vertex.
+	fan := []int{v}
+	inFan := make([]bool, len(adj))
+	inFan[v] = true
+	for extended := true; extended; {
+		extended = false
+		last := fan[len(fan)-1]
+		for w, b := range adj[u] {
+			if b && !inFan[w] && colours[u][w] != -1 && freeColour(colours, last, colours[u][w]) {
+				fan = append(fan, w)
+				inFan[w] = true
+				extended = true
+				break
+			}
+		}
+	}
+	c, d := free(u), free(fan[len(fan)-1])
+
+	// Swap the colours of the path starting at u whose edges alternate between
+	// d and c, so d becomes free at u.
+	var path [][2]int
+	for x, e, previous := u, d, -1; ; {
+		y := -1
+		for w, b := range colours[x] {
+			if b == e && w != previous {
+				y = w
+				break
+			}
+		}
+		if y == -1 {
+			break
+		}
+		path = append(path, [2]int{x, y})
+		x, previous = y, x
+		e = c + d - e
+	}
+	for _, edge := range path {
+		x, y := edge[0], edge[1]
+		colours[x][y] = c + d - colours[x][y]
+		colours[y][x] = colours[x][y]
+	}
+
+	// Rotate the longest prefix of the fan that is still a fan and ends at a
+	// vertex where d is free.
+	end := 0
+	for i := range fan {
+		if i > 0 && !freeColour(colours, fan[i-1], colours[u][fan[i]]) {
+			break
+		}
+		if freeColour(colours, fan[i], d) {
+			end = i
+			break
+		}
+	}
+	for i := 0; i < end; i++ {
+		colours[u][fan[i]] = colours[u][fan[i+1]]
+		colours[fan[i]][u] = colours[u][fan[i]]
+	}
+	colours[u][fan[end]], colours[fan[end]][u] = d, d
+}
+
+// MisraGriesEdgeColouring returns a proper edge colouring of a graph with at
+// most Δ + 1 colours, where Δ is its maximum degree, computed in polynomial
+// time with the algorithm of Misra and Gries, following the proof of Vizing's
+// theorem.
+func MisraGriesEdgeColouring(g *StaticGraph) [][]int {
+	adj := simpleAdjacency(g)
+	colours := uncolouredEdges(len(adj))
+	colourCount := maximumDegree(adj) + 1
+	for u := range adj {
+		for v := u + 1; v < len(adj); v++ {
+			if adj[u][v] {
+				misraGriesStep(adj, colours, u, v, colourCount)
+			}
+		}
+	}
+	return colours
+}
+
+// Returns a proper edge colouring of an adjacency relation with k colours, or
+// nil if there is none. The search colours next the edge with the fewest
+// available colours, and only tries one colour that has not been used yet,
+// since those colours are interchangeable.
+func edgeColouringSearch(adj [][]bool, k int) [][]int {
+	var edges [][2]int
+	for u := range adj {
+		for v := u + 1; v < len(adj); v++ {
+			if adj[u][v] {
+				edges = append(edges, [2]int{u, v})
+			}
+		}
+	}
+	colours := uncolouredEdges(len(adj))
+	// used[v][c] tells whether an edge at v has colour c.
+	used := make([][]bool, len(adj))
+	for v := range used {
+		used[v] = make([]bool, k)
+	}
+	coloured := make([]bool, len(edges))
+	var search func(count, colourCount int) bool
+	search = func(count, colourCount int) bool {
+		if count == len(edges) {
+			return true
+		}
+		next, fewest := -1, k+1
+		for i, edge := range edges {
+			if coloured[i] {
+				continue
+			}
+			available := 0
+			for c := 0; c < k; c++ {
+				if !used[edge[0]][c] && !used[edge[1]][c] {
+					available++
+				}
+			}
+			if available < fewest {
+				next, fewest = i, available
+			}
+		}
+		if fewest == 0 {
+			return false
+		}
+		u, v := edges[next][0], edges[next][1]
+		coloured[next] = true
+		for c := 0; c < k && c <= colourCount; c++ {
+			if used[u][c] || used[v][c] {
+				continue
+			}
+			used[u][c], used[v][c] = true, true
+			colours[u][v], colours[v][u] = c, c
+			newCount := colourCount
+			if c == colourCount {
+				newCount++
+			}
+			if search(count+1, newCount) {
+				return true
+			}
+			used[u][c], used[v][c] = false, false
+		}
+		colours[u][v], colours[v][u] = -1, -1
+		coloured[next] = false
+		return false
+	}
+	if !search(0, 0) {
+		return nil
+	}
+	return colours
+}
+
+// MinimumEdgeColouring returns a proper edge colouring of a graph with the
+// least number of colours, along with that number, its chromatic index. By
+// Vizing's theorem, the chromatic index is Δ or Δ + 1, where Δ is the maximum
+// degree, so an exhaustive search looks for a colouring with Δ colours, and
+// otherwise the colouring of Misra and Gries is returned. The search takes
+// exponential time, so it is intended for small graphs.
+func MinimumEdgeColouring(g *StaticGraph) ([][]int, int) {
+	adj := simpleAdjacency(g)
+	k := maximumDegree(adj)
+	if colours := edgeColouringSearch(adj, k); colours != nil {
+		return colours, k
+	}
+	return MisraGriesEdgeColouring(g), k + 1
+}
+
+// ChromaticIndex returns the least number of colours in a proper edge
+// colouring of a graph.
+func ChromaticIndex(g *StaticGraph) int {
+	_, k := MinimumEdgeColouring(g)
+	return k
+}
+
+// VizingClass returns 1 if the chromatic index of a graph equals its maximum
+// degree Δ, and 2 if it is Δ + 1. For instance, the snarks are the cubic graphs
+// of class 2 without bridges and with girth at least 5.
+func VizingClass(g *StaticGraph) int {
+	adj := simpleAdjacency(g)
+	if edgeColouringSearch(adj, maximumDegree(adj)) != nil {
+		return 1
+	}
+	return 2
+}
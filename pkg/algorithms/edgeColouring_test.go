@@ -0,0 +1,147 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/operations"
+)
+
+// Returns the number of colours of a proper edge colouring of a graph, or -1
+// if the colouring is not proper or does not colour exactly the edges.
+func checkEdgeColouring(g *StaticGraph, colours [][]int) int {
+	a := graph.MatrixOf(g)
+	count := 0
+	for u := range a {
+		seen := make(map[int]bool)
+		for v := range a {
+			if (a[u][v] != 0 && u != v) != (colours[u][v] != -1) ||
+				colours[u][v] != colours[v][u] {
+				return -1
+			}
+			if c := colours[u][v]; c != -1 {
+				if seen[c] {
+					return -1
+				}
+				seen[c] = true
+				if c+1 > count {
+					count = c + 1
+				}
+			}
+		}
+	}
+	return count
+}
+
+// Returns the chromatic number of a graph by trying every colouring.
+func bruteForceChromaticNumber(g *StaticGraph) int {
+	a := graph.MatrixOf(g)
+	n := len(a)
+	colours := make([]int, n)
+	var colour func(v, k int) bool
+	colour = func(v, k int) bool {
+		if v == n {
+			return true
+		}
+		for c := 0; c < k; c++ {
+			proper := true
+			for w := 0; w < v; w++ {
+				if a[v][w] != 0 && colours[w] == c {
+					proper = false
+				}
+			}
+			if proper {
+				colours[v] = c
+				if colour(v+1, k) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	k := 0
+	for !colour(0, k) {
+		k++
+	}
+	return k
+}
+
+// TestEdgeColouringRandom checks on random graphs that the colourings are
+// proper, that Misra–Gries uses at most Δ + 1 colours, and that the chromatic
+// index is the chromatic number of the line graph.
+func TestEdgeColouringRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(43))
+	for k := 0; k < 40; k++ {
+		n := 1 + r.Intn(8)
+		g, _ := generators.GnpRandomGraph(n, r.Float64(), r)
+		delta := maximumDegree(simpleAdjacency(g))
+		if c := checkEdgeColouring(g, MisraGriesEdgeColouring(g)); c == -1 || c > delta+1 {
+			t.Errorf("Expected a proper colouring with at most %v colours, got %v", delta+1, c)
+		}
+		colours, index := MinimumEdgeColouring(g)
+		if c := checkEdgeColouring(g, colours); c != index {
+			t.Errorf("Expected a proper colouring with %v colours, got %v", index, c)
+		}
+		line, _ := operations.LineGraph(g)
+		if expected := bruteForceChromaticNumber(line); index != expected {
+			t.Errorf("Expected chromatic index %v, got %v", expected, index)
+		}
+		if class := VizingClass(g); class != 1+index-delta {
+			t.Errorf("Expected class %v, got %v", 1+index-delta, class)
+		}
+	}
+	for k := 0; k < 10; k++ {
+		g, _ := generators.GnpRandomGraph(30, 0.4, r)
+		delta := maximumDegree(simpleAdjacency(g))
+		if c := checkEdgeColouring(g, MisraGriesEdgeColouring(g)); c == -1 || c > delta+1 {
+			t.Errorf("Expected a proper colouring with at most %v colours, got %v", delta+1, c)
+		}
+	}
+}
+
+// TestVizingClass checks the class of complete graphs and cycles, and that the
+// Petersen graph is the only bridgeless connected cubic graph of class 2 with
+// at most 10 vertices.
+func TestVizingClass(t *testing.T) {
+	for n := 2; n < 8; n++ {
+		expected := 1 + n%2
+		if class := VizingClass(generators.CompleteMatrixGraph(n)); class != expected {
+			t.Errorf("Expected K%v to have class %v, got %v", n, expected, class)
+		}
+	}
+	for n := 3; n < 9; n++ {
+		expected := 1 + n%2
+		if index := ChromaticIndex(generators.MatrixCycle(n)); index != 1+expected {
+			t.Errorf("Expected C%v to have chromatic index %v, got %v", n, 1+expected, index)
+		}
+	}
+	if index := ChromaticIndex(generators.PetersenListGraph()); index != 4 {
+		t.Errorf("Expected %v, got %v", 4, index)
+	}
+	for n := 4; n <= 10; n += 2 {
+		count := 0
+		generators.RegularGraphs(n, 3, true, func(g *StaticGraph) {
+			a, _ := g.Matrix()
+			for u := range a {
+				for v := u + 1; v < n; v++ {
+					if k, _ := LocalEdgeConnectivity(g, u, v); a[u][v] == 1 && k == 1 {
+						return
+					}
+				}
+			}
+			if VizingClass(g) == 2 {
+				count++
+			}
+		})
+		expected := 0
+		if n == 10 {
+			expected = 1
+		}
+		if count != expected {
+			t.Errorf("Expected %v bridgeless cubic graphs of class 2 of order %v, got %v",
+				expected, n, count)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Package algorithms provides classical algorithms on graphs and digraphs.
+package algorithms
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+type Graph = graph.Graph
+type StaticGraph = graph.StaticGraph
+type StaticDigraph = graph.StaticDigraph
+
+// A Flow is a maximum flow from a source to a sink in a digraph, together
+// with a minimum cut separating them.
+type Flow struct {
+	// Value is the value of the flow, which equals the capacity of the cut.
+	Value int
+
+	// Arcs holds the flow through every arc, Arcs[u][v] is the flow through
+	// the arc from u to v.
+	Arcs [][]int
+
+	// SourceSide holds, in increasing order, the vertices that are reachable
+	// from the source in the residual digraph. The arcs leaving this set form
+	// a minimum cut.
+	SourceSide []int
+}
+
+// Builds the residual capacities of a digraph, the capacity of each arc is
+// the corresponding entry of its adjacency matrix. Loops are ignored.
+func residualCapacities(matrix graph.AdjacencyMatrix) [][]int {
+	residual := make([][]int, len(matrix))
+	for i := range matrix {
+		residual[i] = make([]int, len(matrix))
+		for j, c := range matrix[i] {
+			if i != j {
+				residual[i][j] = int(c)
+			}
+		}
+	}
+	return residual
+}
+
+// Checks that source and sink are two different vertices of a digraph of
+// order n.
+func checkSourceAndSink(n, s, t int) error {
+	if s < 0 || s >= n || t < 0 || t >= n {
+		return InvalidVertex
+	}
+	if s == t {
+		return SourceIsSink
+	}
+	return nil
+}
+
+// Builds a Flow from the original capacities and the final residual
+// capacities. The flow through each arc is its net flow, when positive.
+func buildFlow(matrix graph.AdjacencyMatrix, residual [][]int, s int) *Flow {
+	n := len(matrix)
+	flow := &Flow{
+		Value:      0,
+		Arcs:       make([][]int, n),
+		SourceSide: nil,
+	}
+	for i := range matrix {
+		flow.Arcs[i] = make([]int, n)
+		for j, c := range matrix[i] {
+			if i != j && int(c) > residual[i][j] {
+				flow.Arcs[i][j] = int(c) - residual[i][j]
+			}
+		}
+	}
+	for v := 0; v < n; v++ {
+		flow.Value += flow.Arcs[s][v] - flow.Arcs[v][s]
+	}
+	reached := make([]bool, n)
+	reached[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for v := 0; v < n; v++ {
+			if !reached[v] && residual[u][v] > 0 {
+				reached[v] = true
+				queue = append(queue, v)
+			}
+		}
+	}
+	for v, r := range reached {
+		if r {
+			flow.SourceSide = append(flow.SourceSide, v)
+		}
+	}
+	return flow
+}
+
+// MaxFlowDinic computes a maximum flow from s to t in a digraph using Dinic's
+// algorithm. The capacity of each arc is taken from the adjacency matrix of
+// the digraph.
+func MaxFlowDinic(d *StaticDigraph, s, t int) (*Flow, error) {
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+	if err := checkSourceAndSink(n, s, t); err != nil {
+		return nil, err
+	}
+	residual := residualCapacities(matrix)
+	level := make([]int, n)
+	next := make([]int, n)
+
+	// Computes the level graph, returns whether the sink is reachable.
+	bfs := func() bool {
+		for i := range level {
+			level[i] = -1
+		}
+		level[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for v := 0; v < n; v++ {
+				if level[v] == -1 && residual[u][v] > 0 {
+					level[v] = level[u] + 1
+					queue = append(queue, v)
+				}
+			}
+		}
+		return level[t] != -1
+	}
+
+	// Pushes at most limit units of flow from u towards the sink through the
+	// level graph, returns the amount of flow pushed.
+	var dfs func(u, limit int) int
+	dfs = func(u, limit int) int {
+		if u == t {
+			return limit
+		}
+		for ; next[u] < n; next[u]++ {
+			v := next[u]
+			if residual[u][v] > 0 && level[v] == level[u]+1 {
+				c := limit
+				if residual[u][v] < c {
+					c = residual[u][v]
+				}
+				if pushed := dfs(v, c); pushed > 0 {
+					residual[u][v] -= pushed
+					residual[v][u] += pushed
+					return pushed
+				}
+			}
+		}
+		return 0
+	}
+
+	limit := 1
+	for _, c := range residual[s] {
+		limit += c
+	}
+	for bfs() {
+		for i := range next {
+			next[i] = 0
+		}
+		for dfs(s, limit) > 0 {
+		}
+	}
+	return buildFlow(matrix, residual, s), nil
+}
+
+// MaxFlowPushRelabel computes a maximum flow from s to t in a digraph using the
+// FIFO push-relabel algorithm. The capacity of each arc is taken from the
+// adjacency matrix of the digraph.
+func MaxFlowPushRelabel(d *StaticDigraph, s, t int) (*Flow, error) {
+	matrix := graph.MatrixOf(d)
+	n := len(matrix)
+	if err := checkSourceAndSink(n, s, t); err != nil {
+		return nil, err
+	}
+	residual := residualCapacities(matrix)
+	height := make([]int, n)
+	excess := make([]int, n)
+	next := make([]int, n)
+	active := make([]bool, n)
+	var queue []int
+
+	push := func(u, v int) {
+		c := excess[u]
+		if residual[u][v] < c {
+			c = residual[u][v]
+		}
+		residual[u][v] -= c
+		residual[v][u] += c
+		excess[u] -= c
+		excess[v] += c
+		if v != s && v != t && !active[v] {
+			active[v] = true
+			queue = append(queue, v)
+		}
+	}
+
+	height[s] = n
+	for v := 0; v < n; v++ {
+		if residual[s][v] > 0 {
+			excess[s] += residual[s][v]
+			push(s, v)
+		}
+	}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		active[u] = false
+		for excess[u] > 0 {
+			if next[u] == n {
+				// Relabel.
+				minimum := -1
+				for v := 0; v < n; v++ {
+					if residual[u][v] > 0 && (minimum == -1 || height[v] < minimum) {
+						minimum = height[v]
+					}
+				}
+				height[u] = minimum + 1
+				next[u] = 0
+				continue
+			}
+			v := next[u]
+			if residual[u][v] > 0 && height[u] == height[v]+1 {
+				push(u, v)
+			} else {
+				next[u]++
+			}
+		}
+	}
+	return buildFlow(matrix, residual, s), nil
+}
+
+// MinCut returns a minimum cut separating s from t in a digraph, given by the
+// vertices on the side of s, together with its capacity.
+func MinCut(d *StaticDigraph, s, t int) ([]int, int, error) {
+	flow, err := MaxFlowDinic(d, s, t)
+	if err != nil {
+		return nil, 0, err
+	}
+	return flow.SourceSide, flow.Value, nil
+}
@@ -0,0 +1,134 @@
+package algorithms
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Checks that a flow respects the capacities of the digraph and the
+// conservation of flow at every vertex other than s and t, and that the
+// capacity of the cut equals the value of the flow.
+func checkFlow(t *testing.T, matrix [][]byte, s, sink int, flow *Flow) {
+	n := len(matrix)
+	for i := 0; i < n; i++ {
+		balance := 0
+		for j := 0; j < n; j++ {
+			if flow.Arcs[i][j] < 0 || flow.Arcs[i][j] > int(matrix[i][j]) {
+				t.Errorf("Flow %v through arc (%v, %v) exceeds its capacity",
+					flow.Arcs[i][j], i, j)
+			}
+			balance += flow.Arcs[i][j] - flow.Arcs[j][i]
+		}
+		if i != s && i != sink && balance != 0 {
+			t.Errorf("Flow is not conserved at vertex %v", i)
+		}
+	}
+	side := make([]bool, n)
+	for _, v := range flow.SourceSide {
+		side[v] = true
+	}
+	if !side[s] || side[sink] {
+		t.Errorf("Cut %v does not separate %v from %v", flow.SourceSide, s, sink)
+	}
+	capacity := 0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if side[i] && !side[j] {
+				capacity += int(matrix[i][j])
+			}
+		}
+	}
+	if capacity != flow.Value {
+		t.Errorf("Expected cut capacity %v, got %v", flow.Value, capacity)
+	}
+}
+
+// TestMaxFlow computes the maximum flow on the digraph of figure 26.1 of
+// "Introduction to Algorithms" by Cormen et al., whose value is 23.
+func TestMaxFlow(t *testing.T) {
+	matrix := [][]byte{
+		{0, 16, 13, 0, 0, 0},
+		{0, 0, 10, 12, 0, 0},
+		{0, 4, 0, 0, 14, 0},
+		{0, 0, 9, 0, 0, 20},
+		{0, 0, 0, 7, 0, 4},
+		{0, 0, 0, 0, 0, 0},
+	}
+	d := graph.NewDigraphFromMatrix(matrix)
+	for _, f := range []func(*StaticDigraph, int, int) (*Flow, error){
+		MaxFlowDinic, MaxFlowPushRelabel,
+	} {
+		flow, err := f(d, 0, 5)
+		if err != nil {
+			t.Errorf("Didn't expect an error, got %v", err)
+		}
+		if flow.Value != 23 {
+			t.Errorf("Expected %v, got %v", 23, flow.Value)
+		}
+		checkFlow(t, matrix, 0, 5, flow)
+	}
+	side, value, _ := MinCut(d, 0, 5)
+	if want := []int{0, 1, 2, 4}; !reflect.DeepEqual(want, side) {
+		t.Errorf("Expected %v, got %v", want, side)
+	}
+	if value != 23 {
+		t.Errorf("Expected %v, got %v", 23, value)
+	}
+	if _, err := MaxFlowDinic(d, 0, 0); err != SourceIsSink {
+		t.Errorf("Expected %v, got %v", SourceIsSink, err)
+	}
+	if _, err := MaxFlowPushRelabel(d, 0, 6); err != InvalidVertex {
+		t.Errorf("Expected %v, got %v", InvalidVertex, err)
+	}
+}
+
+// TestMaxFlowRandom compares Dinic's and the push-relabel algorithms on
+// randomly generated digraphs.
+func TestMaxFlowRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(26))
+	for k := 0; k < 50; k++ {
+		n := 2 + r.Intn(10)
+		matrix := make([][]byte, n)
+		for i := range matrix {
+			matrix[i] = make([]byte, n)
+			for j := range matrix[i] {
+				if i != j && r.Intn(3) == 0 {
+					matrix[i][j] = byte(1 + r.Intn(9))
+				}
+			}
+		}
+		d := graph.NewDigraphFromMatrix(matrix)
+		s, sink := 0, n-1
+		dinic, _ := MaxFlowDinic(d, s, sink)
+		pushRelabel, _ := MaxFlowPushRelabel(d, s, sink)
+		if dinic.Value != pushRelabel.Value {
+			t.Errorf("Dinic found %v, push-relabel found %v", dinic.Value,
+				pushRelabel.Value)
+		}
+		checkFlow(t, matrix, s, sink, dinic)
+		checkFlow(t, matrix, s, sink, pushRelabel)
+	}
+}
+
+// TestMaxFlowFromList checks that capacities of a digraph modelled by its
+// adjacency list are one for every arc.
+func TestMaxFlowFromList(t *testing.T) {
+	list := [][]int{
+		{1, 2, 3},
+		{4},
+		{4},
+		{4},
+		{},
+	}
+	d := graph.NewDigraphFromList(list)
+	flow, err := MaxFlowDinic(d, 0, 4)
+	if err != nil {
+		t.Errorf("Didn't expect an error, got %v", err)
+	}
+	if flow.Value != 3 {
+		t.Errorf("Expected %v, got %v", 3, flow.Value)
+	}
+}
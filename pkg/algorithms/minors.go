@@ -0,0 +1,103 @@
+package algorithms
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// A graph H is a minor of a graph G if it can be obtained from G by deleting
+// vertices and edges and contracting edges. Equivalently, G has a model of H:
+// disjoint connected sets of vertices of G, the branch sets, one for each
+// vertex of H, such that there is an edge of G between the branch sets of
+// every two adjacent vertices of H. Since deletions can always be done last,
+// H is a minor of G if and only if H is a subgraph of a graph obtained from G
+// by contracting edges.
+
+// A graph obtained by contracting edges, along with the branch set of every
+// vertex.
+type contraction struct {
+	adj  [][]bool
+	sets [][]int
+}
+
+// Returns the graph obtained by contracting the edge uv, where u < v, keeping
+// u and removing v.
+func (c *contraction) contract(u, v int) *contraction {
+	n := len(c.adj)
+	result := &contraction{}
+	for x := 0; x < n; x++ {
+		if x == v {
+			continue
+		}
+		row := make([]bool, 0, n-1)
+		for y := 0; y < n; y++ {
+			if y == v {
+				continue
+			}
+			b := c.adj[x][y]
+			if x == u && y != u {
+				b = b || c.adj[v][y]
+			} else if y == u && x != u {
+				b = b || c.adj[x][v]
+			}
+			row = append(row, b)
+		}
+		result.adj = append(result.adj, row)
+		set := c.sets[x]
+		if x == u {
+			set = append(append([]int(nil), c.sets[u]...), c.sets[v]...)
+		}
+		result.sets = append(result.sets, set)
+	}
+	return result
+}
+
+// FindMinor returns a model of a graph h in a graph g, as the branch sets of
+// the vertices of h, or nil if h is not a minor of g. The search goes through
+// the graphs obtained from g by contracting edges, up to isomorphism, so it
+// takes exponential time and is intended for small graphs and small minors,
+// like K4, K5 or K(3,3).
+func FindMinor(g, h *StaticGraph) [][]int {
+	pattern := graph.NewFromMatrix(toMatrix(simpleAdjacency(h)))
+	order, size := pattern.Order(), pattern.Size()
+	start := &contraction{adj: simpleAdjacency(g)}
+	for v := range start.adj {
+		start.sets = append(start.sets, []int{v})
+	}
+	seen := make(map[string]bool)
+	var search func(c *contraction) [][]int
+	search = func(c *contraction) [][]int {
+		current := graph.NewFromMatrix(toMatrix(c.adj))
+		if len(c.adj) < order || current.Size() < size {
+			return nil
+		}
+		certificate := isomorphism.Certificate(current)
+		if seen[certificate] {
+			return nil
+		}
+		seen[certificate] = true
+		if e := isomorphism.FindSubgraph(pattern, current); e != nil {
+			model := make([][]int, order)
+			for i, v := range e {
+				model[i] = c.sets[v]
+			}
+			return model
+		}
+		for u := range c.adj {
+			for v := u + 1; v < len(c.adj); v++ {
+				if c.adj[u][v] {
+					if model := search(c.contract(u, v)); model != nil {
+						return model
+					}
+				}
+			}
+		}
+		return nil
+	}
+	return search(start)
+}
+
+// HasMinor returns whether a graph h is a minor of a graph g.
+func HasMinor(g, h *StaticGraph) bool {
+	return FindMinor(g, h) != nil
+}
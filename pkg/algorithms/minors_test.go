@@ -0,0 +1,110 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+)
+
+// Returns whether some sets of vertices are a model of h in g.
+func isMinorModel(g, h *StaticGraph, model [][]int) bool {
+	adj, pattern := simpleAdjacency(g), simpleAdjacency(h)
+	if len(model) != len(pattern) {
+		return false
+	}
+	owner := make([]int, len(adj))
+	for v := range owner {
+		owner[v] = -1
+	}
+	for i, set := range model {
+		if len(set) == 0 {
+			return false
+		}
+		for _, v := range set {
+			if owner[v] != -1 {
+				return false
+			}
+			owner[v] = i
+		}
+		// Every branch set is connected.
+		seen := map[int]bool{set[0]: true}
+		stack := []int{set[0]}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for w, b := range adj[v] {
+				if b && owner[w] == i && !seen[w] {
+					seen[w] = true
+					stack = append(stack, w)
+				}
+			}
+		}
+		if len(seen) != len(set) {
+			return false
+		}
+	}
+	for i := range pattern {
+		for j := range pattern {
+			if !pattern[i][j] {
+				continue
+			}
+			joined := false
+			for _, u := range model[i] {
+				for _, v := range model[j] {
+					if adj[u][v] {
+						joined = true
+					}
+				}
+			}
+			if !joined {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// TestFindMinor checks on random graphs that K4 is a minor exactly when the
+// treewidth is at least 3, and checks the minors of some named graphs.
+func TestFindMinor(t *testing.T) {
+	k4 := generators.CompleteMatrixGraph(4)
+	r := rand.New(rand.NewSource(46))
+	for k := 0; k < 30; k++ {
+		g, _ := generators.GnpRandomGraph(1+r.Intn(8), r.Float64(), r)
+		model := FindMinor(g, k4)
+		if w, _ := Treewidth(g); (model != nil) != (w >= 3) {
+			t.Errorf("Expected %v, got %v", w >= 3, model)
+		}
+		if model != nil && !isMinorModel(g, k4, model) {
+			t.Errorf("Invalid model %v", model)
+		}
+	}
+	k5 := generators.CompleteMatrixGraph(5)
+	k33 := generators.CompleteBipartiteMatrixGraph(3, 3)
+	wheel, _ := generators.WheelMatrixGraph(8)
+	tests := []struct {
+		g, h     *StaticGraph
+		expected bool
+	}{
+		{generators.PetersenMatrixGraph(), k5, true},
+		{generators.PetersenMatrixGraph(), k33, true},
+		{wheel, k4, true},
+		{wheel, k5, false},
+		{generators.HypercubeMatrixGraph(3), k33, false},
+		{k33, k5, false},
+		{generators.MatrixCycle(8), generators.MatrixCycle(5), true},
+	}
+	for _, test := range tests {
+		model := FindMinor(test.g, test.h)
+		if (model != nil) != test.expected {
+			t.Errorf("Expected %v, got %v", test.expected, model)
+		}
+		if model != nil && !isMinorModel(test.g, test.h, model) {
+			t.Errorf("Invalid model %v", model)
+		}
+		if HasMinor(test.g, test.h) != test.expected {
+			t.Errorf("Expected %v, got %v", test.expected, !test.expected)
+		}
+	}
+}
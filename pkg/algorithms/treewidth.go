@@ -0,0 +1,403 @@
+package algorithms
+
+import (
+	"sort"
+)
+
+// A TreeDecomposition of a graph is a tree whose nodes are bags of vertices,
+// such that every vertex and every edge of the graph is contained in some
+// bag, and the bags containing each vertex induce a subtree.
+type TreeDecomposition struct {
+	// Bags holds the vertices of every node of the tree, in increasing order.
+	Bags [][]int
+
+	// Edges holds the edges of the tree, as pairs of indices of bags.
+	Edges [][2]int
+}
+
+// Width returns the order of the largest bag of the decomposition minus one.
+func (d *TreeDecomposition) Width() int {
+	width := -1
+	for _, bag := range d.Bags {
+		if len(bag)-1 > width {
+			width = len(bag) - 1
+		}
+	}
+	return width
+}
+
+// Returns whether the bags of a decomposition joined by some edges form a
+// valid decomposition of an adjacency relation.
+func isDecomposition(adj [][]bool, bags [][]int, edges [][2]int) bool {
+	n, k := len(adj), len(bags)
+	if (k == 0 && n > 0) || (k > 0 && len(edges) != k-1) {
+		return false
+	}
+	tree := make([][]int, k)
+	for _, e := range edges {
+		if e[0] < 0 || e[0] >= k || e[1] < 0 || e[1] >= k || e[0] == e[1] {
+			return false
+		}
+		tree[e[0]] = append(tree[e[0]], e[1])
+		tree[e[1]] = append(tree[e[1]], e[0])
+	}
+	contains := make([][]bool, k)
+	for i, bag := range bags {
+		contains[i] = make([]bool, n)
+		for _, v := range bag {
+			if v < 0 || v >= n || contains[i][v] {
+				return false
+			}
+			contains[i][v] = true
+		}
+	}
+	// With k-1 edges, the tree is a tree if and only if it is connected.
+	component := func(keep func(i int) bool, start int) int {
+		seen := make([]bool, k)
+		seen[start] = true
+		stack := []int{start}
+		count := 1
+		for len(stack) > 0 {
+			i := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, j := range tree[i] {
+				if !seen[j] && keep(j) {
+					seen[j] = true
+					count++
+					stack = append(stack, j)
+				}
+			}
+		}
+		return count
+	}
+	if k > 0 && component(func(int) bool { return true }, 0) != k {
+		return false
+	}
+	for v := 0; v < n; v++ {
+		var holding []int
+		for i := range bags {
+			if contains[i][v] {
+				holding = append(holding, i)
+			}
+		}
+		if len(holding) == 0 {
+			return false
+		}
+		if component(func(i int) bool { return contains[i][v] }, holding[0]) != len(holding) {
+			return false
+		}
+		for w := v + 1; w < n; w++ {
+			if !adj[v][w] {
+				continue
+			}
+			covered := false
+			for _, i := range holding {
+				if contains[i][w] {
+					covered = true
+				}
+			}
+			if !covered {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsTreeDecomposition returns whether a tree decomposition is valid for a
+// graph.
+func IsTreeDecomposition(g *StaticGraph, d *TreeDecomposition) bool {
+	return isDecomposition(simpleAdjacency(g), d.Bags, d.Edges)
+}
+
+// IsPathDecomposition returns whether a sequence of bags is a valid path
+// decomposition of a graph, a tree decomposition whose tree is a path joining
+// consecutive bags.
+func IsPathDecomposition(g *StaticGraph, bags [][]int) bool {
+	var edges [][2]int
+	for i := 1; i < len(bags); i++ {
+		edges = append(edges, [2]int{i - 1, i})
+	}
+	return isDecomposition(simpleAdjacency(g), bags, edges)
+}
+
+// Builds the tree decomposition given by an elimination ordering. When a
+// vertex is eliminated, its remaining neighbours are made pairwise adjacent,
+// and its bag holds the vertex and those neighbours. Each bag is joined to the
+// bag of the neighbour eliminated first, or to the next bag if there is none.
+func eliminationDecomposition(adj [][]bool, order []int) *TreeDecomposition {
+	n := len(adj)
+	filled := make([][]bool, n)
+	for v := range adj {
+		filled[v] = append([]bool(nil), adj[v]...)
+	}
+	position := make([]int, n)
+	for i, v := range order {
+		position[v] = i
+	}
+	d := &TreeDecomposition{Bags: make([][]int, n)}
+	for i, v := range order {
+		bag := []int{v}
+		parent := -1
+		for w, b := range filled[v] {
+			if b && position[w] > i {
+				bag = append(bag, w)
+				if parent == -1 || position[w] < parent {
+					parent = position[w]
+				}
+			}
+		}
+		for _, x := range bag[1:] {
+			for _, y := range bag[1:] {
+				if x != y {
+					filled[x][y] = true
+				}
+			}
+		}
+		sort.Ints(bag)
+		d.Bags[i] = bag
+		if parent == -1 && i < n-1 {
+			parent = i + 1
+		}
+		if parent != -1 {
+			d.Edges = append(d.Edges, [2]int{i, parent})
+		}
+	}
+	return d
+}
+
+// Returns an elimination ordering that greedily eliminates a vertex
+// minimizing a cost, computed on the graph where the eliminated vertices have
+// been removed and their neighbourhoods made cliques.
+func greedyElimination(adj [][]bool, cost func(filled [][]bool, eliminated []bool, v int) int) []int {
+	n := len(adj)
+	filled := make([][]bool, n)
+	for v := range adj {
+		filled[v] = append([]bool(nil), adj[v]...)
+	}
+	eliminated := make([]bool, n)
+	order := make([]int, 0, n)
+	for len(order) < n {
+		v, best := -1, 0
+		for w := 0; w < n; w++ {
+			if eliminated[w] {
+				continue
+			}
+			if c := cost(filled, eliminated, w); v == -1 || c < best {
+				v, best = w, c
+			}
+		}
+		eliminated[v] = true
+		order = append(order, v)
+		for x, b := range filled[v] {
+			for y, c := range filled[v] {
+				if b && c && x != y && !eliminated[x] && !eliminated[y] {
+					filled[x][y] = true
+				}
+			}
+		}
+	}
+	return order
+}
+
+// Returns the remaining neighbours of a vertex during an elimination.
+func remainingNeighbours(filled [][]bool, eliminated []bool, v int) []int {
+	var neighbours []int
+	for w, b := range filled[v] {
+		if b && !eliminated[w] {
+			neighbours = append(neighbours, w)
+		}
+	}
+	return neighbours
+}
+
+// MinDegreeDecomposition returns a tree decomposition of a graph built with
+// the minimum degree heuristic, which repeatedly eliminates a vertex of least
+// degree. Its width is an upper bound for the treewidth.
+func MinDegreeDecomposition(g *StaticGraph) *TreeDecomposition {
+	adj := simpleAdjacency(g)
+	return eliminationDecomposition(adj, greedyElimination(adj,
+		func(filled [][]bool, eliminated []bool, v int) int {
+			return len(remainingNeighbours(filled, eliminated, v))
+		}))
+}
+
+// MinFillDecomposition returns a tree decomposition of a graph built with the
+// minimum fill-in heuristic, which repeatedly eliminates a vertex whose
+// elimination adds the fewest edges. Its width is an upper bound for the
+// treewidth.
+func MinFillDecomposition(g *StaticGraph) *TreeDecomposition {
+	adj := simpleAdjacency(g)
+	return eliminationDecomposition(adj, greedyElimination(adj,
+		func(filled [][]bool, eliminated []bool, v int) int {
+			neighbours := remainingNeighbours(filled, eliminated, v)
+			fill := 0
+			for i, x := range neighbours {
+				for _, y := range neighbours[i+1:] {
+					if !filled[x][y] {
+						fill++
+					}
+				}
+			}
+			return fill
+		}))
+}
+
+// Returns the adjacency relation of a graph as bit masks, or an error if the
+// graph is too large for the exact searches.
+func adjacencyMasks(g *StaticGraph) ([][]bool, []uint64, error) {
+	adj := simpleAdjacency(g)
+	if len(adj) > 64 {
+		return nil, nil, GraphTooLarge
+	}
+	masks := make([]uint64, len(adj))
+	for v := range adj {
+		for w, b := range adj[v] {
+			if b {
+				masks[v] |= 1 << uint(w)
+			}
+		}
+	}
+	return adj, masks, nil
+}
+
+// Returns the number of vertices outside s and v reachable from v through
+// paths whose inner vertices are in s. These are the neighbours of v once the
+// vertices in s have been eliminated.
+func eliminatedDegree(masks []uint64, s uint64, v int) int {
+	seen := uint64(1) << uint(v)
+	frontier := seen
+	outside := uint64(0)
+	for frontier != 0 {
+		next := uint64(0)
+		for w := range masks {
+			if frontier&(1<<uint(w)) != 0 {
+				next |= masks[w]
+			}
+		}
+		next &^= seen
+		seen |= next
+		outside |= next &^ s
+		frontier = next & s
+	}
+	count := 0
+	for ; outside != 0; outside &= outside - 1 {
+		count++
+	}
+	return count
+}
+
+// Solves the dynamic program over the subsets of the vertices, where the value
+// of a set is the minimum over its vertices v of the maximum of the value of
+// the set without v and the cost of placing v after the rest of the set, and
+// returns an optimal ordering of all the vertices.
+func subsetOrdering(n int, cost func(s uint64, v int) int) []int {
+	value := map[uint64]int{0: -1}
+	choice := make(map[uint64]int)
+	var solve func(s uint64) int
+	solve = func(s uint64) int {
+		if x, ok := value[s]; ok {
+			return x
+		}
+		best := -1
+		for v := 0; v < n; v++ {
+			if s&(1<<uint(v)) == 0 {
+				continue
+			}
+			rest := s &^ (1 << uint(v))
+			x := solve(rest)
+			if c := cost(rest, v); c > x {
+				x = c
+			}
+			if best == -1 || x < best {
+				best = x
+				choice[s] = v
+			}
+		}
+		value[s] = best
+		return best
+	}
+	all := uint64(1)<<uint(n) - 1
+	if n == 64 {
+		all = ^uint64(0)
+	}
+	solve(all)
+	order := make([]int, n)
+	for s, i := all, n-1; i >= 0; i-- {
+		order[i] = choice[s]
+		s &^= 1 << uint(choice[s])
+	}
+	return order
+}
+
+// OptimalTreeDecomposition returns a tree decomposition of a graph of minimum
+// width, computed with the dynamic program over subsets of Bodlaender, Fomin,
+// Koster, Kratsch and Thilikos, which finds an optimal elimination ordering.
+// It takes exponential time and space, so it is intended for small graphs; if
+// the graph has more than 64 vertices, an error is returned.
+func OptimalTreeDecomposition(g *StaticGraph) (*TreeDecomposition, error) {
+	adj, masks, err := adjacencyMasks(g)
+	if err != nil {
+		return nil, err
+	}
+	order := subsetOrdering(len(adj), func(s uint64, v int) int {
+		return eliminatedDegree(masks, s, v)
+	})
+	return eliminationDecomposition(adj, order), nil
+}
+
+// Treewidth returns the least width of a tree decomposition of a graph, or an
+// error if the graph has more than 64 vertices.
+func Treewidth(g *StaticGraph) (int, error) {
+	d, err := OptimalTreeDecomposition(g)
+	if err != nil {
+		return 0, err
+	}
+	return d.Width(), nil
+}
+
+// OptimalPathDecomposition returns a path decomposition of a graph of minimum
+// width, as a sequence of bags. It finds an ordering of the vertices of least
+// vertex separation number, the largest number of vertices in a prefix of the
+// ordering with neighbours after it, which equals the pathwidth. It takes
+// exponential time and space, so it is intended for small graphs; if the graph
+// has more than 64 vertices, an error is returned.
+func OptimalPathDecomposition(g *StaticGraph) ([][]int, error) {
+	adj, masks, err := adjacencyMasks(g)
+	if err != nil {
+		return nil, err
+	}
+	boundary := func(s uint64) []int {
+		var vertices []int
+		for u := range masks {
+			if s&(1<<uint(u)) != 0 && masks[u]&^s != 0 {
+				vertices = append(vertices, u)
+			}
+		}
+		return vertices
+	}
+	order := subsetOrdering(len(adj), func(s uint64, v int) int {
+		return len(boundary(s | 1<<uint(v)))
+	})
+	// Each bag holds a vertex and the vertices before it with neighbours from
+	// it onwards.
+	bags := make([][]int, len(order))
+	s := uint64(0)
+	for i, v := range order {
+		bags[i] = append(boundary(s), v)
+		sort.Ints(bags[i])
+		s |= 1 << uint(v)
+	}
+	return bags, nil
+}
+
+// Pathwidth returns the least width of a path decomposition of a graph, or an
+// error if the graph has more than 64 vertices.
+func Pathwidth(g *StaticGraph) (int, error) {
+	bags, err := OptimalPathDecomposition(g)
+	if err != nil {
+		return 0, err
+	}
+	d := &TreeDecomposition{Bags: bags}
+	return d.Width(), nil
+}
@@ -0,0 +1,121 @@
+package algorithms
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+)
+
+// Calls f with every permutation of {0, ..., n-1}.
+func forEachOrdering(n int, f func([]int)) {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	var permute func(k int)
+	permute = func(k int) {
+		if k == n {
+			f(order)
+			return
+		}
+		for i := k; i < n; i++ {
+			order[k], order[i] = order[i], order[k]
+			permute(k + 1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+	permute(0)
+}
+
+// TestWidthsRandom compares the treewidth and pathwidth of random graphs with
+// the least widths over every elimination ordering and every vertex ordering,
+// and checks that all the decompositions are valid.
+func TestWidthsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(46))
+	for k := 0; k < 30; k++ {
+		n := 1 + r.Intn(7)
+		g, _ := generators.GnpRandomGraph(n, r.Float64(), r)
+		adj := simpleAdjacency(g)
+		treewidth, pathwidth := n, n
+		forEachOrdering(n, func(order []int) {
+			if w := eliminationDecomposition(adj, order).Width(); w < treewidth {
+				treewidth = w
+			}
+			w := 0
+			for i := range order {
+				separated := 0
+				for _, u := range order[:i+1] {
+					for _, v := range order[i+1:] {
+						if adj[u][v] {
+							separated++
+							break
+						}
+					}
+				}
+				if separated > w {
+					w = separated
+				}
+			}
+			if w < pathwidth {
+				pathwidth = w
+			}
+		})
+		d, _ := OptimalTreeDecomposition(g)
+		if !IsTreeDecomposition(g, d) || d.Width() != treewidth {
+			t.Errorf("Expected a tree decomposition of width %v, got %v", treewidth, d)
+		}
+		bags, _ := OptimalPathDecomposition(g)
+		if w, _ := Pathwidth(g); !IsPathDecomposition(g, bags) || w != pathwidth {
+			t.Errorf("Expected a path decomposition of width %v, got %v", pathwidth, bags)
+		}
+		for _, h := range []*TreeDecomposition{MinDegreeDecomposition(g), MinFillDecomposition(g)} {
+			if !IsTreeDecomposition(g, h) || h.Width() < treewidth {
+				t.Errorf("Invalid heuristic decomposition %v", h)
+			}
+		}
+	}
+}
+
+// TestWidthsKnownValues checks the widths of complete graphs, cycles, trees, a
+// cube and the Petersen graph, and that invalid decompositions are rejected.
+func TestWidthsKnownValues(t *testing.T) {
+	tests := []struct {
+		g                    *StaticGraph
+		treewidth, pathwidth int
+	}{
+		{generators.CompleteMatrixGraph(6), 5, 5},
+		{generators.MatrixCycle(9), 2, 2},
+		{generators.MatrixPath(9), 1, 1},
+		{generators.StarMatrixGraph(8), 1, 1},
+		{generators.HypercubeMatrixGraph(3), 3, 4},
+		{generators.PetersenMatrixGraph(), 4, 5},
+	}
+	for _, test := range tests {
+		if w, _ := Treewidth(test.g); w != test.treewidth {
+			t.Errorf("Expected treewidth %v, got %v", test.treewidth, w)
+		}
+		if w, _ := Pathwidth(test.g); w != test.pathwidth {
+			t.Errorf("Expected pathwidth %v, got %v", test.pathwidth, w)
+		}
+		if w := MinFillDecomposition(test.g).Width(); w < test.treewidth {
+			t.Errorf("Expected width at least %v, got %v", test.treewidth, w)
+		}
+	}
+	triangle := generators.CompleteMatrixGraph(3)
+	invalid := []*TreeDecomposition{
+		{Bags: [][]int{{0, 1}, {1, 2}}, Edges: [][2]int{{0, 1}}},
+		{Bags: [][]int{{0, 1, 2}, {0}}, Edges: nil},
+		{Bags: [][]int{{0, 1}, {1, 2}, {0, 2}}, Edges: [][2]int{{0, 1}, {1, 2}}},
+		{Bags: [][]int{{0, 1, 3}}, Edges: nil},
+	}
+	for _, d := range invalid {
+		if IsTreeDecomposition(triangle, d) {
+			t.Errorf("Expected %v to be invalid", d)
+		}
+	}
+	if !IsPathDecomposition(triangle, [][]int{{0}, {0, 1, 2}, {2}}) ||
+		IsPathDecomposition(triangle, [][]int{{0, 1}, {2}, {0, 2}}) {
+		t.Errorf("Expected only the first path decomposition to be valid")
+	}
+}
@@ -0,0 +1,266 @@
+package classes
+
+import (
+	"sort"
+)
+
+// Returns an ordering of the vertices obtained by maximum cardinality search,
+// reversed, which is a perfect elimination ordering if the graph is chordal.
+func maximumCardinalityOrdering(adj [][]bool) []int {
+	n := len(adj)
+	weight := make([]int, n)
+	numbered := make([]bool, n)
+	order := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		v := -1
+		for w := 0; w < n; w++ {
+			if !numbered[w] && (v == -1 || weight[w] > weight[v]) {
+				v = w
+			}
+		}
+		order[i] = v
+		numbered[v] = true
+		for w, b := range adj[v] {
+			if b && !numbered[w] {
+				weight[w]++
+			}
+		}
+	}
+	return order
+}
+
+// Returns whether an ordering of the vertices is a perfect elimination
+// ordering: the neighbours of each vertex that come after it form a clique.
+func isPerfectEliminationOrdering(adj [][]bool, order []int) bool {
+	position := make([]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+	for _, v := range order {
+		var later []int
+		for w, b := range adj[v] {
+			if b && position[w] > position[v] {
+				later = append(later, w)
+			}
+		}
+		for i, x := range later {
+			for _, y := range later[i+1:] {
+				if !adj[x][y] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Returns a chordless cycle of length at least 4, or nil if there is none.
+// For every induced path a-b-c, a shortest path from a to c avoiding the other
+// neighbours of b closes such a cycle with b.
+func findHole(adj [][]bool) []int {
+	n := len(adj)
+	for b := 0; b < n; b++ {
+		for a := 0; a < n; a++ {
+			for c := a + 1; c < n; c++ {
+				if !adj[b][a] || !adj[b][c] || adj[a][c] {
+					continue
+				}
+				parent := make([]int, n)
+				for v := range parent {
+					parent[v] = -1
+				}
+				parent[a] = a
+				queue := []int{a}
+				for len(queue) > 0 && parent[c] == -1 {
+					v := queue[0]
+					queue = queue[1:]
+					for w, e := range adj[v] {
+						if e && parent[w] == -1 && w != b && (w == c || !adj[b][w]) {
+							parent[w] = v
+							queue = append(queue, w)
+						}
+					}
+				}
+				if parent[c] == -1 {
+					continue
+				}
+				hole := []int{b}
+				for v := c; v != a; v = parent[v] {
+					hole = append(hole, v)
+				}
+				return append(hole, a)
+			}
+		}
+	}
+	return nil
+}
+
+// IsChordal returns whether a graph is chordal, that is, whether it has no
+// chordless cycle of length at least 4. If it is, a perfect elimination
+// ordering is returned, where the neighbours of each vertex that come after it
+// form a clique; otherwise, a chordless cycle is returned, with its vertices
+// in order.
+func IsChordal(g *StaticGraph) (bool, []int, []int) {
+	adj := adjacency(g)
+	order := maximumCardinalityOrdering(adj)
+	if isPerfectEliminationOrdering(adj, order) {
+		return true, order, nil
+	}
+	return false, nil, findHole(adj)
+}
+
+// Returns an asteroidal triple, three pairwise non-adjacent vertices such that
+// every two of them are joined by a path avoiding the neighbourhood of the
+// third, or nil if there is none.
+func findAsteroidalTriple(adj [][]bool) []int {
+	n := len(adj)
+	// avoiding[x] marks the closed neighbourhood of x.
+	avoiding := make([][]bool, n)
+	for x := 0; x < n; x++ {
+		blocked := make([]bool, n)
+		blocked[x] = true
+		for w, b := range adj[x] {
+			if b {
+				blocked[w] = true
+			}
+		}
+		avoiding[x] = blocked
+	}
+	joined := func(u, v, x int) bool {
+		return reachable(adj, u, avoiding[x])[v]
+	}
+	for a := 0; a < n; a++ {
+		for b := a + 1; b < n; b++ {
+			for c := b + 1; c < n; c++ {
+				if adj[a][b] || adj[a][c] || adj[b][c] {
+					continue
+				}
+				if joined(a, b, c) && joined(a, c, b) && joined(b, c, a) {
+					return []int{a, b, c}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// IsInterval returns whether a graph is an interval graph, the intersection
+// graph of a family of intervals of the real line. If it is, an interval model
+// is returned, where vertices are adjacent if and only if their closed
+// intervals [l, r] intersect. Otherwise, either a chordless cycle of length at
+// least 4 or an asteroidal triple is returned, following the characterization
+// of Lekkerkerker and Boland.
+func IsInterval(g *StaticGraph) (bool, [][2]int, []int) {
+	adj := adjacency(g)
+	if chordal, _, hole := IsChordal(g); !chordal {
+		return false, nil, hole
+	}
+	if triple := findAsteroidalTriple(adj); triple != nil {
+		return false, nil, triple
+	}
+	// The complement of an interval graph has a transitive orientation, where
+	// u precedes v when the interval of u lies to the left of that of v. The
+	// sets of predecessors are nested, so the left endpoint of a vertex is
+	// twice the number of its predecessors, and its right endpoint lies just
+	// before the left endpoint of its first successor.
+	orientation, _ := transitiveOrientation(complement(adj))
+	n := len(adj)
+	predecessors := make([]int, n)
+	for u := range orientation {
+		for v, b := range orientation[u] {
+			if b {
+				predecessors[v]++
+			}
+		}
+	}
+	model := make([][2]int, n)
+	for u := range model {
+		model[u] = [2]int{2 * predecessors[u], 2 * n}
+		for v, b := range orientation[u] {
+			if b && 2*predecessors[v]-1 < model[u][1] {
+				model[u][1] = 2*predecessors[v] - 1
+			}
+		}
+	}
+	return true, model, nil
+}
+
+// IsSplit returns whether a graph is a split graph, whose vertices can be
+// partitioned into a clique and a stable set. If it is, the vertices of such a
+// clique are returned, using the degree criterion of Hammer and Simeone;
+// otherwise, an induced 2K2, C4 or C5 is returned.
+func IsSplit(g *StaticGraph) (bool, []int, []int) {
+	adj := adjacency(g)
+	n := len(adj)
+	degree := make([]int, n)
+	order := make([]int, n)
+	for v := range adj {
+		order[v] = v
+		for _, b := range adj[v] {
+			if b {
+				degree[v]++
+			}
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return degree[order[i]] > degree[order[j]]
+	})
+	m := 0
+	for m < n && degree[order[m]] >= m {
+		m++
+	}
+	left, right := m*(m-1), 0
+	for i, v := range order {
+		if i < m {
+			left -= degree[v]
+		} else {
+			right += degree[v]
+		}
+	}
+	if left+right == 0 {
+		clique := append([]int(nil), order[:m]...)
+		sort.Ints(clique)
+		return true, clique, nil
+	}
+	return false, nil, findInduced(adj, twoEdges, cycle(4), cycle(5))
+}
+
+// IsThreshold returns whether a graph is a threshold graph, one that can be
+// built from the empty graph by repeatedly adding an isolated vertex or a
+// vertex adjacent to every previous one. If it is, the vertices are returned
+// in such an order of construction; otherwise, an induced 2K2, C4 or P4 is
+// returned.
+func IsThreshold(g *StaticGraph) (bool, []int, []int) {
+	adj := adjacency(g)
+	n := len(adj)
+	removed := make([]bool, n)
+	degree := make([]int, n)
+	for v := range adj {
+		for _, b := range adj[v] {
+			if b {
+				degree[v]++
+			}
+		}
+	}
+	order := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		v := -1
+		for w := 0; w < n; w++ {
+			if !removed[w] && (degree[w] == 0 || degree[w] == i) {
+				v = w
+				break
+			}
+		}
+		if v == -1 {
+			return false, nil, findInduced(adj, twoEdges, cycle(4), path4)
+		}
+		order[i] = v
+		removed[v] = true
+		for w, b := range adj[v] {
+			if b {
+				degree[w]--
+			}
+		}
+	}
+	return true, order, nil
+}
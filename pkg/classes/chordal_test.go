@@ -0,0 +1,166 @@
+package classes
+
+import (
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// Returns whether a sequence of vertices induces a subgraph isomorphic to one
+// of the patterns, with the vertices listed as in the patterns.
+func inducesPattern(adj [][]bool, vertices []int, patterns ...*StaticGraph) bool {
+	for _, p := range patterns {
+		a, _ := p.Matrix()
+		if len(a) != len(vertices) {
+			continue
+		}
+		matches := true
+		for i := range a {
+			for j := range a {
+				if i != j && (a[i][j] == 1) != adj[vertices[i]][vertices[j]] {
+					matches = false
+				}
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether a sequence of vertices is a chordless cycle of length at
+// least k.
+func isHole(adj [][]bool, vertices []int, k int) bool {
+	return len(vertices) >= k && inducesPattern(adj, vertices, cycle(len(vertices)))
+}
+
+// Counts the graphs of order n, up to isomorphism, for which a recogniser
+// returns true, and calls check with every graph.
+func countClass(n int, check func(g *StaticGraph) bool) int {
+	count := 0
+	generators.Graphs(n, false, func(g *StaticGraph) {
+		if check(g) {
+			count++
+		}
+	})
+	return count
+}
+
+// TestIsChordal compares the recogniser with the absence of induced cycles of
+// length at least 4 on every graph with at most 6 vertices, and checks the
+// orderings and holes.
+func TestIsChordal(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			chordal, order, hole := IsChordal(g)
+			expected := true
+			for k := 4; k <= n; k++ {
+				if isomorphism.IsInducedSubgraph(cycle(k), g) {
+					expected = false
+				}
+			}
+			if chordal != expected {
+				t.Errorf("Expected %v, got %v for %v", expected, chordal, adj)
+			} else if chordal && (len(order) != n || !isPerfectEliminationOrdering(adj, order)) {
+				t.Errorf("Invalid perfect elimination ordering %v", order)
+			} else if !chordal && !isHole(adj, hole, 4) {
+				t.Errorf("Invalid hole %v", hole)
+			}
+			return chordal
+		})
+	}
+}
+
+// TestIsInterval counts the interval graphs with at most 6 vertices, and
+// checks their models and obstructions.
+func TestIsInterval(t *testing.T) {
+	expected := []int{1, 2, 4, 10, 27, 92}
+	for n := 1; n <= 6; n++ {
+		count := countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			interval, model, obstruction := IsInterval(g)
+			if interval {
+				for u := range adj {
+					for v := range adj {
+						intersect := model[u][0] <= model[v][1] && model[v][0] <= model[u][1]
+						if model[u][0] > model[u][1] || (u != v && intersect != adj[u][v]) {
+							t.Errorf("Invalid interval model %v for %v", model, adj)
+						}
+					}
+				}
+			} else if !isHole(adj, obstruction, 4) &&
+				(len(obstruction) != 3 || findAsteroidalTriple(adj) == nil) {
+				t.Errorf("Invalid obstruction %v", obstruction)
+			}
+			return interval
+		})
+		if count != expected[n-1] {
+			t.Errorf("Expected %v interval graphs of order %v, got %v", expected[n-1], n, count)
+		}
+	}
+}
+
+// TestIsSplit counts the split graphs with at most 6 vertices, and checks their
+// partitions and obstructions.
+func TestIsSplit(t *testing.T) {
+	expected := []int{1, 2, 4, 9, 21, 56}
+	for n := 1; n <= 6; n++ {
+		count := countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			split, clique, obstruction := IsSplit(g)
+			if split {
+				inClique := make([]bool, n)
+				for _, v := range clique {
+					inClique[v] = true
+				}
+				for u := range adj {
+					for v := range adj {
+						if u != v && inClique[u] && inClique[v] && !adj[u][v] ||
+							!inClique[u] && !inClique[v] && adj[u][v] {
+							t.Errorf("Invalid split partition %v for %v", clique, adj)
+						}
+					}
+				}
+			} else if !inducesPattern(adj, obstruction, twoEdges, cycle(4), cycle(5)) {
+				t.Errorf("Invalid obstruction %v", obstruction)
+			}
+			return split
+		})
+		if count != expected[n-1] {
+			t.Errorf("Expected %v split graphs of order %v, got %v", expected[n-1], n, count)
+		}
+	}
+}
+
+// TestIsThreshold counts the threshold graphs with at most 6 vertices, which
+// are 2^(n-1), and checks their orders of construction and obstructions.
+func TestIsThreshold(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		count := countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			threshold, order, obstruction := IsThreshold(g)
+			if threshold {
+				for i, v := range order {
+					degree := 0
+					for _, u := range order[:i] {
+						if adj[u][v] {
+							degree++
+						}
+					}
+					if degree != 0 && degree != i {
+						t.Errorf("Invalid construction order %v for %v", order, adj)
+					}
+				}
+			} else if !inducesPattern(adj, obstruction, twoEdges, cycle(4), path4) {
+				t.Errorf("Invalid obstruction %v", obstruction)
+			}
+			return threshold
+		})
+		if count != 1<<uint(n-1) {
+			t.Errorf("Expected %v threshold graphs of order %v, got %v", 1<<uint(n-1), n, count)
+		}
+	}
+}
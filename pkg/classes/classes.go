@@ -0,0 +1,149 @@
+// Package classes provides recognition of hereditary classes of graphs. Along
+// with its answer, each recogniser returns a certificate of membership or a
+// forbidden induced subgraph showing that the graph is not in the class,
+// whenever there is one. Graphs are considered simple: loops and multiple
+// edges are ignored.
+package classes
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// Type aliases to improve code readability.
+type StaticGraph = graph.StaticGraph
+type StaticDigraph = graph.StaticDigraph
+
+// Returns the adjacency relation of the simple graph underlying a graph.
+func adjacency(g *StaticGraph) [][]bool {
+	a := graph.MatrixOf(g)
+	adj := make([][]bool, len(a))
+	for i := range a {
+		adj[i] = make([]bool, len(a))
+		for j := range a {
+			adj[i][j] = i != j && (a[i][j] != 0 || a[j][i] != 0)
+		}
+	}
+	return adj
+}
+
+// Returns the adjacency relation of the complement.
+func complement(adj [][]bool) [][]bool {
+	c := make([][]bool, len(adj))
+	for i := range adj {
+		c[i] = make([]bool, len(adj))
+		for j := range adj {
+			c[i][j] = i != j && !adj[i][j]
+		}
+	}
+	return c
+}
+
+// Makes the adjacency matrix of an adjacency relation.
+func toMatrix(adj [][]bool) graph.AdjacencyMatrix {
+	a := make([][]byte, len(adj))
+	for i := range adj {
+		a[i] = make([]byte, len(adj))
+		for j, b := range adj[i] {
+			if b {
+				a[i][j] = 1
+			}
+		}
+	}
+	return a
+}
+
+// Makes the graph with a given adjacency relation, modelled by an adjacency
+// matrix.
+func toGraph(adj [][]bool) *StaticGraph {
+	return graph.NewFromMatrix(toMatrix(adj))
+}
+
+// Makes the graph of order n with a given set of edges.
+func fromEdges(n int, edges [][2]int) *StaticGraph {
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = make([]byte, n)
+	}
+	for _, e := range edges {
+		a[e[0]][e[1]], a[e[1]][e[0]] = 1, 1
+	}
+	return graph.NewFromMatrix(a)
+}
+
+// Small forbidden induced subgraphs, numbered so that their embeddings list
+// the vertices of paths and cycles in order, and the centre of the claw first.
+var (
+	twoEdges = fromEdges(4, [][2]int{{0, 1}, {2, 3}})
+	claw     = fromEdges(4, [][2]int{{0, 1}, {0, 2}, {0, 3}})
+	path4    = fromEdges(4, [][2]int{{0, 1}, {1, 2}, {2, 3}})
+)
+
+// Makes the cycle of order n.
+func cycle(n int) *StaticGraph {
+	edges := make([][2]int, n)
+	for i := range edges {
+		edges[i] = [2]int{i, (i + 1) % n}
+	}
+	return fromEdges(n, edges)
+}
+
+// Returns an induced subgraph of an adjacency relation isomorphic to one of
+// the patterns, as the images of the vertices of the pattern, or nil if there
+// is none.
+func findInduced(adj [][]bool, patterns ...*StaticGraph) []int {
+	g := toGraph(adj)
+	for _, p := range patterns {
+		if e := isomorphism.FindInducedSubgraph(p, g); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// Returns the vertices reachable from a vertex without going through the
+// vertices marked as blocked.
+func reachable(adj [][]bool, s int, blocked []bool) []bool {
+	seen := make([]bool, len(adj))
+	seen[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for w, b := range adj[v] {
+			if b && !seen[w] && !blocked[w] {
+				seen[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return seen
+}
+
+// Returns the connected components of the subgraph induced by a set of
+// vertices.
+func components(adj [][]bool, vertices []int) [][]int {
+	blocked := make([]bool, len(adj))
+	for v := range blocked {
+		blocked[v] = true
+	}
+	for _, v := range vertices {
+		blocked[v] = false
+	}
+	var result [][]int
+	for _, v := range vertices {
+		if blocked[v] {
+			continue
+		}
+		seen := reachable(adj, v, blocked)
+		var component []int
+		for _, w := range vertices {
+			if seen[w] {
+				component = append(component, w)
+				blocked[w] = true
+			}
+		}
+		result = append(result, component)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package classes
+
+// A Cotree is the decomposition of a cograph into disjoint unions and joins.
+// Each leaf is a vertex of the graph, and each internal node is either the
+// disjoint union or the join of the graphs of its children, which alternate
+// between unions and joins along every path from the root.
+type Cotree struct {
+	// The vertex of a leaf, or -1 for an internal node.
+	Vertex int
+
+	// Whether an internal node is a join rather than a disjoint union.
+	Join bool
+
+	Children []*Cotree
+}
+
+// Builds the cotree of the subgraph induced by a set of vertices, splitting it
+// into its connected components, or into the connected components of its
+// complement if join is true. Those parts are split the other way in turn. It
+// returns nil if some split is not possible.
+func buildCotree(adj, co [][]bool, vertices []int, join bool) *Cotree {
+	if len(vertices) == 1 {
+		return &Cotree{Vertex: vertices[0]}
+	}
+	relation := adj
+	if join {
+		relation = co
+	}
+	parts := components(relation, vertices)
+	if len(parts) == 1 {
+		return nil
+	}
+	node := &Cotree{Vertex: -1, Join: join}
+	for _, part := range parts {
+		child := buildCotree(adj, co, part, !join)
+		if child == nil {
+			return nil
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node
+}
+
+// IsCograph returns whether a graph is a cograph, one that can be built from
+// single vertices with disjoint unions and complements, or equivalently, one
+// without an induced P4. If it is, its cotree is returned; otherwise, an
+// induced P4 is returned, with its vertices in order. The graph with no
+// vertices has a nil cotree.
+func IsCograph(g *StaticGraph) (bool, *Cotree, []int) {
+	adj := adjacency(g)
+	if len(adj) == 0 {
+		return true, nil, nil
+	}
+	co := complement(adj)
+	vertices := make([]int, len(adj))
+	for v := range vertices {
+		vertices[v] = v
+	}
+	join := len(components(adj, vertices)) == 1
+	if tree := buildCotree(adj, co, vertices, join); tree != nil {
+		return true, tree, nil
+	}
+	return false, nil, findInduced(adj, path4)
+}
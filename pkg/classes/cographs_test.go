@@ -0,0 +1,59 @@
+package classes
+
+import (
+	"testing"
+)
+
+// Marks the edges of the graph described by a cotree, returning its leaves.
+func cotreeEdges(tree *Cotree, adj [][]bool) []int {
+	if tree.Vertex != -1 {
+		return []int{tree.Vertex}
+	}
+	var leaves []int
+	for _, child := range tree.Children {
+		vertices := cotreeEdges(child, adj)
+		if tree.Join {
+			for _, u := range leaves {
+				for _, v := range vertices {
+					adj[u][v], adj[v][u] = true, true
+				}
+			}
+		}
+		leaves = append(leaves, vertices...)
+	}
+	return leaves
+}
+
+// TestIsCograph counts the cographs with at most 6 vertices, and checks that
+// their cotrees describe them and that the obstructions are induced paths.
+func TestIsCograph(t *testing.T) {
+	expected := []int{1, 2, 4, 10, 24, 66}
+	for n := 1; n <= 6; n++ {
+		count := countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			cograph, tree, obstruction := IsCograph(g)
+			if cograph {
+				built := make([][]bool, n)
+				for v := range built {
+					built[v] = make([]bool, n)
+				}
+				if leaves := cotreeEdges(tree, built); len(leaves) != n {
+					t.Errorf("Expected %v leaves, got %v", n, leaves)
+				}
+				for u := range adj {
+					for v := range adj {
+						if adj[u][v] != built[u][v] {
+							t.Errorf("Cotree does not describe %v", adj)
+						}
+					}
+				}
+			} else if !inducesPattern(adj, obstruction, path4) {
+				t.Errorf("Invalid obstruction %v", obstruction)
+			}
+			return cograph
+		})
+		if count != expected[n-1] {
+			t.Errorf("Expected %v cographs of order %v, got %v", expected[n-1], n, count)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package classes
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns a transitive orientation of an adjacency relation, where
+// orientation[u][v] tells whether the edge uv is oriented from u to v, or nil
+// if there is none. It follows the algorithm of Golumbic: the implication
+// class of an edge, the arcs forced by orienting it, is oriented and removed,
+// and this is repeated on the remaining edges. The graph has no transitive
+// orientation if some class forces an edge in both directions, and then the
+// ends of that edge are returned instead.
+func transitiveOrientation(adj [][]bool) ([][]bool, []int) {
+	n := len(adj)
+	remaining := make([][]bool, n)
+	orientation := make([][]bool, n)
+	for u := range adj {
+		remaining[u] = append([]bool(nil), adj[u]...)
+		orientation[u] = make([]bool, n)
+	}
+	for a := 0; a < n; a++ {
+		for b := a + 1; b < n; b++ {
+			if !remaining[a][b] {
+				continue
+			}
+			class := make([][]bool, n)
+			for u := range class {
+				class[u] = make([]bool, n)
+			}
+			class[a][b] = true
+			arcs := [][2]int{{a, b}}
+			for i := 0; i < len(arcs); i++ {
+				u, v := arcs[i][0], arcs[i][1]
+				// The arc uv forces uw when vw is not an edge, and wv when uw
+				// is not an edge.
+				var forced [][2]int
+				for w := 0; w < n; w++ {
+					if w != v && w != u && remaining[u][w] && !remaining[v][w] {
+						forced = append(forced, [2]int{u, w})
+					}
+					if w != u && w != v && remaining[w][v] && !remaining[u][w] {
+						forced = append(forced, [2]int{w, v})
+					}
+				}
+				for _, arc := range forced {
+					x, y := arc[0], arc[1]
+					if class[y][x] {
+						return nil, []int{x, y}
+					}
+					if !class[x][y] {
+						class[x][y] = true
+						arcs = append(arcs, arc)
+					}
+				}
+			}
+			for _, arc := range arcs {
+				x, y := arc[0], arc[1]
+				orientation[x][y] = true
+				remaining[x][y], remaining[y][x] = false, false
+			}
+		}
+	}
+	return orientation, nil
+}
+
+// IsComparability returns whether a graph is a comparability graph, one whose
+// edges can be oriented transitively, so that it is the comparability graph of
+// a partial order. If it is, such an orientation is returned as a digraph
+// modelled by an adjacency matrix; otherwise, the ends of an edge that is
+// forced in both directions by the implication classes of the graph are
+// returned.
+func IsComparability(g *StaticGraph) (bool, *StaticDigraph, []int) {
+	orientation, forced := transitiveOrientation(adjacency(g))
+	if orientation == nil {
+		return false, nil, forced
+	}
+	return true, graph.NewDigraphFromMatrix(toMatrix(orientation)), nil
+}
+
+// IsPermutation returns whether a graph is a permutation graph, one whose
+// vertices can be listed in two orders so that two vertices are adjacent if
+// and only if they appear in different relative order in each of them. If it
+// is, two such orders are returned. A graph is a permutation graph if and only
+// if both the graph and its complement are comparability graphs, and the orders
+// are obtained by combining their transitive orientations. Otherwise, the ends
+// of an edge forced in both directions are returned as in IsComparability,
+// along with whether that edge belongs to the complement rather than to the
+// graph.
+func IsPermutation(g *StaticGraph) (bool, [2][]int, []int, bool) {
+	adj := adjacency(g)
+	t1, forced := transitiveOrientation(adj)
+	if t1 == nil {
+		return false, [2][]int{}, forced, false
+	}
+	t2, forced := transitiveOrientation(complement(adj))
+	if t2 == nil {
+		return false, [2][]int{}, forced, true
+	}
+	// The union of both orientations is a transitive tournament, so each
+	// vertex is placed after its predecessors; reversing the orientation of
+	// the graph gives the second order.
+	n := len(adj)
+	var orders [2][]int
+	for k := range orders {
+		orders[k] = make([]int, n)
+		for v := 0; v < n; v++ {
+			position := 0
+			for u := 0; u < n; u++ {
+				if t2[u][v] || (k == 0 && t1[u][v]) || (k == 1 && t1[v][u]) {
+					position++
+				}
+			}
+			orders[k][position] = v
+		}
+	}
+	return true, orders, nil, false
+}
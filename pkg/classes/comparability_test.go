@@ -0,0 +1,135 @@
+package classes
+
+import (
+	"testing"
+)
+
+// Returns whether an orientation is transitive and its underlying graph is a
+// given one.
+func isTransitiveOrientation(adj, orientation [][]bool) bool {
+	for u := range adj {
+		for v := range adj {
+			if orientation[u][v] && orientation[v][u] ||
+				adj[u][v] != (orientation[u][v] || orientation[v][u]) {
+				return false
+			}
+			for w := range adj {
+				if orientation[u][v] && orientation[v][w] && !orientation[u][w] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Returns whether the ends of an edge forced in both directions form an edge of
+// an adjacency relation.
+func isForcedEdge(adj [][]bool, forced []int) bool {
+	return len(forced) == 2 && adj[forced[0]][forced[1]]
+}
+
+// Returns whether an adjacency relation has a transitive orientation, by
+// trying every orientation.
+func bruteForceComparability(adj [][]bool) bool {
+	var edges [][2]int
+	for u := range adj {
+		for v := u + 1; v < len(adj); v++ {
+			if adj[u][v] {
+				edges = append(edges, [2]int{u, v})
+			}
+		}
+	}
+	for mask := 0; mask < 1<<uint(len(edges)); mask++ {
+		orientation := make([][]bool, len(adj))
+		for u := range orientation {
+			orientation[u] = make([]bool, len(adj))
+		}
+		for i, e := range edges {
+			if mask&(1<<uint(i)) != 0 {
+				orientation[e[0]][e[1]] = true
+			} else {
+				orientation[e[1]][e[0]] = true
+			}
+		}
+		if isTransitiveOrientation(adj, orientation) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestIsComparability compares the recogniser with a brute force search on
+// every graph with at most 5 vertices, and checks the orientations and the
+// edges forced in both directions of every graph with 6 vertices.
+func TestIsComparability(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			comparability, d, forced := IsComparability(g)
+			if n <= 5 && comparability != bruteForceComparability(adj) {
+				t.Errorf("Expected %v, got %v for %v", !comparability, comparability, adj)
+			}
+			if comparability {
+				orientation := make([][]bool, n)
+				a, _ := d.Matrix()
+				for u := range a {
+					orientation[u] = make([]bool, n)
+					for v := range a {
+						orientation[u][v] = a[u][v] == 1
+					}
+				}
+				if !isTransitiveOrientation(adj, orientation) {
+					t.Errorf("Invalid transitive orientation %v for %v", a, adj)
+				}
+			} else if !isForcedEdge(adj, forced) {
+				t.Errorf("Expected an edge of %v, got %v", adj, forced)
+			}
+			return comparability
+		})
+	}
+}
+
+// TestIsPermutation checks the orders of the permutation graphs with at most 6
+// vertices, and that the rest of the graphs or their complements are not
+// comparability graphs, as shown by the edges forced in both directions.
+func TestIsPermutation(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			permutation, orders, forced, complemented := IsPermutation(g)
+			if permutation {
+				var position [2][]int
+				for k, order := range orders {
+					position[k] = make([]int, n)
+					for i, v := range order {
+						position[k][v] = i
+					}
+				}
+				for u := range adj {
+					for v := range adj {
+						reversed := (position[0][u] < position[0][v]) != (position[1][u] < position[1][v])
+						if u != v && reversed != adj[u][v] {
+							t.Errorf("Invalid orders %v for %v", orders, adj)
+						}
+					}
+				}
+			} else {
+				failed := adj
+				if complemented {
+					failed = complement(adj)
+					if orientation, _ := transitiveOrientation(adj); orientation == nil {
+						t.Errorf("Expected %v to be a comparability graph", adj)
+					}
+				}
+				if orientation, _ := transitiveOrientation(failed); orientation != nil {
+					t.Errorf("Expected %v to be a permutation graph", adj)
+				}
+				if !isForcedEdge(failed, forced) {
+					t.Errorf("Expected an edge of %v, got %v", failed, forced)
+				}
+			}
+			return permutation
+		})
+	}
+}
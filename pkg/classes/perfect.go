@@ -0,0 +1,33 @@
+package classes
+
+// IsPerfect returns whether a graph is perfect, that is, whether the chromatic
+// number of every induced subgraph equals its clique number. By the Strong
+// Perfect Graph Theorem, this holds if and only if the graph has no odd hole
+// (a chordless cycle of odd length at least 5) and no odd antihole (the
+// complement of one). If it is not perfect, such a hole or antihole is
+// returned, with the vertices in the order of the cycle in the graph or in its
+// complement. The search takes exponential time, so it is intended for small
+// graphs.
+func IsPerfect(g *StaticGraph) (bool, []int) {
+	adj := adjacency(g)
+	co := complement(adj)
+	for k := 5; k <= len(adj); k += 2 {
+		c := cycle(k)
+		if hole := findInduced(adj, c); hole != nil {
+			return false, hole
+		}
+		if antihole := findInduced(co, c); antihole != nil {
+			return false, antihole
+		}
+	}
+	return true, nil
+}
+
+// IsClawFree returns whether a graph has no induced claw K(1,3). If it has
+// one, its vertices are returned, starting with its centre.
+func IsClawFree(g *StaticGraph) (bool, []int) {
+	if c := findInduced(adjacency(g), claw); c != nil {
+		return false, c
+	}
+	return true, nil
+}
@@ -0,0 +1,49 @@
+package classes
+
+import (
+	"testing"
+)
+
+// TestIsPerfect counts the perfect graphs with at most 6 vertices, and checks
+// that the obstructions are odd holes or odd antiholes.
+func TestIsPerfect(t *testing.T) {
+	expected := []int{1, 2, 4, 11, 33, 148}
+	for n := 1; n <= 6; n++ {
+		count := countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			perfect, obstruction := IsPerfect(g)
+			if !perfect && (len(obstruction)%2 == 0 || !isHole(adj, obstruction, 5) &&
+				!isHole(complement(adj), obstruction, 5)) {
+				t.Errorf("Invalid obstruction %v", obstruction)
+			}
+			return perfect
+		})
+		if count != expected[n-1] {
+			t.Errorf("Expected %v perfect graphs of order %v, got %v", expected[n-1], n, count)
+		}
+	}
+	// The complement of C7 is an odd antihole.
+	if perfect, obstruction := IsPerfect(toGraph(complement(adjacency(cycle(7))))); perfect ||
+		len(obstruction) != 7 {
+		t.Errorf("Expected an antihole of order 7, got %v", obstruction)
+	}
+}
+
+// TestIsClawFree counts the claw-free graphs with at most 6 vertices, and
+// checks the claws found.
+func TestIsClawFree(t *testing.T) {
+	expected := []int{1, 2, 4, 10, 26, 85}
+	for n := 1; n <= 6; n++ {
+		count := countClass(n, func(g *StaticGraph) bool {
+			adj := adjacency(g)
+			clawFree, obstruction := IsClawFree(g)
+			if !clawFree && !inducesPattern(adj, obstruction, claw) {
+				t.Errorf("Invalid claw %v", obstruction)
+			}
+			return clawFree
+		})
+		if count != expected[n-1] {
+			t.Errorf("Expected %v claw-free graphs of order %v, got %v", expected[n-1], n, count)
+		}
+	}
+}
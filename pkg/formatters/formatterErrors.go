@@ -0,0 +1,12 @@
+package formatters
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+var (
+	MalformedInput      = graph.GraphError("Input does not follow the expected format")
+	EdgeCountMismatch   = graph.GraphError("Number of edges does not match the header")
+	AsymmetricAdjacency = graph.GraphError("Adjacencies of the graph are not symmetric")
+	LoopsNotSupported   = graph.GraphError("Format does not support loops")
+)
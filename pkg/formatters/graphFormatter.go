@@ -411,48 +411,10 @@ func FromSparse6(s string) *StaticGraph {
 	return G
 }
 
-// Return the set of edges in a graph as byte matrix where
-// each row is the pair of edges.
-// Note: This method is only auxiliary and in a future it
-// should be expected to be replaced by a method of the graph
-// structure.
-func getEdgePairs(graph *StaticGraph) [][]int {
-	var pairs [][]int
-
-	// Let n be the order of the graph.
-	n := graph.Order()
-
-	// Let M be the adjacency matrix of the graph.
-	M, _ := graph.Matrix()
-
-	// Traverse the upper triangle of the matrix as it is
-	// symmetric, and consider the diagonal.
-	for i := 0; i < n; i++ {
-		for j := 0; j <= i; j++ {
-			// If an edge exists, create a pair and add it to the
-			// list.
-			if M[i][j] > 0 {
-				numEdges := int(M[i][j])
-
-				for e := 0; e < numEdges; e++ {
-					pair := make([]int, 2)
-					pair[0] = i
-					pair[1] = j
-
-					pairs = append(pairs, [][]int{pair}...)
-				}
-
-			}
-		}
-	}
-
-	return pairs
-}
-
 // Sort the edge pairs according to the sparse6 format:
 //  1. Sort each edge in descending order.
 //  2. Sort in ascending order the matrix based on the 0-th
-//     column.
+//     column, and then on the 1-st column.
 func sortEdgePairs(pairs [][]int) [][]int {
 	numPairs := len(pairs)
 
@@ -469,7 +431,10 @@ func sortEdgePairs(pairs [][]int) [][]int {
 
 	// Sort each row based on the 0-th column.
 	sort.Slice(sortedPairs, func(i, j int) bool {
-		return sortedPairs[i][0] < sortedPairs[j][0]
+		if sortedPairs[i][0] != sortedPairs[j][0] {
+			return sortedPairs[i][0] < sortedPairs[j][0]
+		}
+		return sortedPairs[i][1] < sortedPairs[j][1]
 	})
 
 	return sortedPairs
@@ -530,8 +495,14 @@ func ToSparse6(graph *StaticGraph) string {
 	// Let n be the order of the graph.
 	n := graph.Order()
 
-	// Obtain the edge pairs.
-	edgePairs := getEdgePairs(graph)
+	// Obtain the edge pairs, where an edge appears as many times as its
+	// multiplicity.
+	var edgePairs [][]int
+	for _, e := range graph.Edges() {
+		for k := 0; k < e.Multiplicity; k++ {
+			edgePairs = append(edgePairs, []int{e.U, e.V})
+		}
+	}
 
 	// Sort the pairs according to the sparse6 format.
 	sortedPairs := sortEdgePairs(edgePairs)
@@ -0,0 +1,375 @@
+package formatters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns the fields of every line of a text which is neither blank nor a
+// comment, where comments are the lines starting with one of the prefixes.
+func dataLines(s string, prefixes ...string) [][]string {
+	var lines [][]string
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || hasPrefix(fields[0], prefixes) {
+			continue
+		}
+		lines = append(lines, fields)
+	}
+	return lines
+}
+
+// Returns whether a string starts with one of the prefixes.
+func hasPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses a list of integers, subtracting an offset from each one.
+func parseInts(fields []string, offset int) ([]int, error) {
+	values := make([]int, len(fields))
+	for i, f := range fields {
+		v, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, MalformedInput
+		}
+		values[i] = v - offset
+	}
+	return values, nil
+}
+
+// Returns an empty adjacency matrix of order n.
+func emptyMatrix(n int) [][]byte {
+	matrix := make([][]byte, n)
+	for i := range matrix {
+		matrix[i] = make([]byte, n)
+	}
+	return matrix
+}
+
+// Returns the graph with the given adjacency matrix, or AsymmetricAdjacency if
+// the matrix is not symmetric.
+func symmetricGraph(matrix [][]byte) (*StaticGraph, error) {
+	g, err := graph.NewGraphFromMatrix(matrix)
+	if err != nil {
+		return nil, AsymmetricAdjacency
+	}
+	return g, nil
+}
+
+// ToEdgeList returns the edge list format of a graph. The first line holds the
+// order and the number of edge lines, and every other line holds the
+// endpoints of an edge, numbering the vertices from 0. If weighted is true,
+// every edge appears once followed by its multiplicity as a weight; otherwise,
+// an edge appears in as many lines as its multiplicity.
+func ToEdgeList(g *StaticGraph, weighted bool) string {
+	var lines []string
+	for _, e := range g.Edges() {
+		if weighted {
+			lines = append(lines, fmt.Sprintf("%d %d %d", e.U, e.V, e.Multiplicity))
+			continue
+		}
+		for k := 0; k < e.Multiplicity; k++ {
+			lines = append(lines, fmt.Sprintf("%d %d", e.U, e.V))
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %d\n", g.Order(), len(lines))
+	for _, line := range lines {
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+// FromEdgeList returns the graph given in the edge list format, as written by
+// ToEdgeList. Lines starting with '#' or '%' are comments. Every edge line may
+// have a third field with its weight, which is added to the multiplicity of
+// the edge, and edges without a weight count once. If an endpoint is not a
+// vertex or a weight is not between 1 and 255, graph.InvalidEdge is returned.
+func FromEdgeList(s string) (*StaticGraph, error) {
+	lines := dataLines(s, "#", "%")
+	if len(lines) == 0 || len(lines[0]) != 2 {
+		return nil, MalformedInput
+	}
+	header, err := parseInts(lines[0], 0)
+	if err != nil || header[0] < 0 {
+		return nil, MalformedInput
+	}
+	if header[1] != len(lines)-1 {
+		return nil, EdgeCountMismatch
+	}
+	edges := make([]graph.Edge, 0, header[1])
+	for _, line := range lines[1:] {
+		if len(line) != 2 && len(line) != 3 {
+			return nil, MalformedInput
+		}
+		values, err := parseInts(line, 0)
+		if err != nil {
+			return nil, err
+		}
+		e := graph.Edge{U: values[0], V: values[1], Multiplicity: 1}
+		if len(values) == 3 {
+			e.Multiplicity = values[2]
+		}
+		edges = append(edges, e)
+	}
+	return graph.NewGraphFromEdges(header[0], edges)
+}
+
+// ToDIMACS returns the DIMACS format (as used by the .col and .clq benchmark
+// files) of a graph. The problem line holds the order and the number of edges,
+// and every edge line holds its endpoints, numbering the vertices from 1. The
+// format describes simple graphs, so parallel edges are written once.
+func ToDIMACS(g *StaticGraph) string {
+	edges := g.Edges()
+	var b strings.Builder
+	fmt.Fprintf(&b, "p edge %d %d\n", g.Order(), len(edges))
+	for _, e := range edges {
+		fmt.Fprintf(&b, "e %d %d\n", e.U+1, e.V+1)
+	}
+	return b.String()
+}
+
+// FromDIMACS returns the graph given in the DIMACS format. Lines starting with
+// 'c' are comments, and the problem line must come before the edge lines,
+// though any format name (such as edge, col or clq) is accepted in it. Many
+// benchmark files repeat edges or list them in both directions, so repeated
+// edges are merged and the number of edges in the problem line is not
+// checked. Vertex lines, starting with 'n', are ignored.
+func FromDIMACS(s string) (*StaticGraph, error) {
+	var matrix [][]byte
+	for _, line := range dataLines(s, "c") {
+		switch {
+		case line[0] == "p" && len(line) == 4 && matrix == nil:
+			n, err := strconv.Atoi(line[2])
+			if err != nil || n < 0 {
+				return nil, MalformedInput
+			}
+			matrix = emptyMatrix(n)
+		case line[0] == "e" && len(line) == 3 && matrix != nil:
+			values, err := parseInts(line[1:], 1)
+			if err != nil {
+				return nil, err
+			}
+			u, v := values[0], values[1]
+			if u < 0 || u >= len(matrix) || v < 0 || v >= len(matrix) {
+				return nil, graph.InvalidEdge
+			}
+			matrix[u][v], matrix[v][u] = 1, 1
+		case line[0] == "n":
+		default:
+			return nil, MalformedInput
+		}
+	}
+	if matrix == nil {
+		return nil, MalformedInput
+	}
+	return graph.NewFromMatrix(matrix), nil
+}
+
+// ToMETIS returns the METIS graph format of a graph. The header holds the
+// order and the size, and the i-th following line holds the neighbours of the
+// i-th vertex, numbering the vertices from 1. If weighted is true, every
+// neighbour is followed by the multiplicity of the edge as its weight;
+// otherwise, parallel edges are written once. The format does not allow loops,
+// so LoopsNotSupported is returned if the graph has any.
+func ToMETIS(g *StaticGraph, weighted bool) (string, error) {
+	n := g.Order()
+	rows := make([][]string, n)
+	for _, e := range g.Edges() {
+		if e.U == e.V {
+			return "", LoopsNotSupported
+		}
+		for _, arc := range [][2]int{{e.U, e.V}, {e.V, e.U}} {
+			entry := strconv.Itoa(arc[1] + 1)
+			if weighted {
+				entry += " " + strconv.Itoa(e.Multiplicity)
+			}
+			rows[arc[0]] = append(rows[arc[0]], entry)
+		}
+	}
+	var b strings.Builder
+	if weighted {
+		fmt.Fprintf(&b, "%d %d 001\n", n, len(g.Edges()))
+	} else {
+		fmt.Fprintf(&b, "%d %d\n", n, len(g.Edges()))
+	}
+	for _, row := range rows {
+		b.WriteString(strings.Join(row, " ") + "\n")
+	}
+	return b.String(), nil
+}
+
+// FromMETIS returns the graph given in the METIS graph format. Lines starting
+// with '%' are comments. The optional format code in the header tells whether
+// the vertex lines start with a vertex size and with vertex weights, which
+// are skipped, and whether every neighbour is followed by an edge weight,
+// which is used as the multiplicity of the edge. If the adjacencies are not
+// symmetric, AsymmetricAdjacency is returned, and if the number of edges does
+// not match the header, EdgeCountMismatch is returned.
+func FromMETIS(s string) (*StaticGraph, error) {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "%") {
+			lines = append(lines, line)
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	if len(lines) == 0 {
+		return nil, MalformedInput
+	}
+	fields := strings.Fields(lines[0])
+	if len(fields) < 2 || len(fields) > 4 {
+		return nil, MalformedInput
+	}
+	header, err := parseInts(fields[:2], 0)
+	if err != nil || header[0] < 0 || len(lines)-1 < header[0] {
+		return nil, MalformedInput
+	}
+	code, constraints := "000", 1
+	if len(fields) > 2 {
+		if len(fields[2]) > 3 || strings.Trim(fields[2], "01") != "" {
+			return nil, MalformedInput
+		}
+		code = strings.Repeat("0", 3-len(fields[2])) + fields[2]
+	}
+	if len(fields) > 3 {
+		if constraints, err = strconv.Atoi(fields[3]); err != nil || constraints < 1 {
+			return nil, MalformedInput
+		}
+	}
+	skip := 0
+	if code[0] == '1' {
+		skip++
+	}
+	if code[1] == '1' {
+		skip += constraints
+	}
+	step := 1
+	if code[2] == '1' {
+		step = 2
+	}
+	n := header[0]
+	matrix := emptyMatrix(n)
+	for u, line := range lines[1 : n+1] {
+		values, err := parseInts(strings.Fields(line), 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) < skip || (len(values)-skip)%step != 0 {
+			return nil, MalformedInput
+		}
+		for i := skip; i < len(values); i += step {
+			v, weight := values[i]-1, 1
+			if step == 2 {
+				weight = values[i+1]
+			}
+			if v < 0 || v >= n || v == u || weight < 1 || weight > 255 {
+				return nil, graph.InvalidEdge
+			}
+			matrix[u][v] = byte(weight)
+		}
+	}
+	for _, line := range lines[n+1:] {
+		if strings.TrimSpace(line) != "" {
+			return nil, MalformedInput
+		}
+	}
+	g, err := symmetricGraph(matrix)
+	if err != nil {
+		return nil, err
+	}
+	if len(g.Edges()) != header[1] {
+		return nil, EdgeCountMismatch
+	}
+	return g, nil
+}
+
+// ToShowg returns the adjacency list text written by the showg program of
+// nauty for some graphs. Every graph starts with a line with its number,
+// counting from 1, and its order, followed by a line for each vertex with
+// its neighbours, numbering the vertices from 0.
+func ToShowg(graphs ...*StaticGraph) string {
+	var b strings.Builder
+	for k, g := range graphs {
+		matrix := graph.MatrixOf(g)
+		fmt.Fprintf(&b, "\nGraph %d, order %d.\n", k+1, len(matrix))
+		for u, row := range matrix {
+			fmt.Fprintf(&b, "%3d :", u)
+			for v, w := range row {
+				if w != 0 {
+					fmt.Fprintf(&b, " %d", v)
+				}
+			}
+			b.WriteString(";\n")
+		}
+	}
+	return b.String()
+}
+
+// FromShowg returns the graphs given in the adjacency list text written by the
+// showg program of nauty. The neighbours of a vertex may span several lines,
+// and end with a semicolon. If the adjacencies of a graph are not symmetric,
+// AsymmetricAdjacency is returned.
+func FromShowg(s string) ([]*StaticGraph, error) {
+	var graphs []*StaticGraph
+	var orders []int
+	var bodies []string
+	for _, line := range strings.Split(s, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "Graph") {
+			var k, n int
+			if _, err := fmt.Sscanf(trimmed, "Graph %d, order %d.", &k, &n); err != nil || n < 0 {
+				return nil, MalformedInput
+			}
+			orders = append(orders, n)
+			bodies = append(bodies, "")
+		} else if len(bodies) > 0 {
+			bodies[len(bodies)-1] += " " + trimmed
+		} else if trimmed != "" {
+			return nil, MalformedInput
+		}
+	}
+	for k, body := range bodies {
+		n := orders[k]
+		segments := strings.Split(body, ";")
+		if len(segments) != n+1 || strings.TrimSpace(segments[n]) != "" {
+			return nil, MalformedInput
+		}
+		matrix := emptyMatrix(n)
+		for u, segment := range segments[:n] {
+			parts := strings.Split(segment, ":")
+			if len(parts) != 2 {
+				return nil, MalformedInput
+			}
+			if v, err := strconv.Atoi(strings.TrimSpace(parts[0])); err != nil || v != u {
+				return nil, MalformedInput
+			}
+			neighbours, err := parseInts(strings.Fields(parts[1]), 0)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range neighbours {
+				if v < 0 || v >= n {
+					return nil, graph.InvalidEdge
+				}
+				matrix[u][v] = 1
+			}
+		}
+		g, err := symmetricGraph(matrix)
+		if err != nil {
+			return nil, err
+		}
+		graphs = append(graphs, g)
+	}
+	return graphs, nil
+}
@@ -0,0 +1,162 @@
+package formatters
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns a random multigraph of order n with loops, where every pair of
+// vertices is joined by up to three parallel edges.
+func randomMultigraph(r *rand.Rand, n int) *StaticGraph {
+	var edges []graph.Edge
+	for u := 0; u < n; u++ {
+		for v := u; v < n; v++ {
+			if k := r.Intn(6); k < 3 {
+				edges = append(edges, graph.Edge{U: u, V: v, Multiplicity: k + 1})
+			}
+		}
+	}
+	g, _ := graph.NewGraphFromEdges(n, edges)
+	return g
+}
+
+// TestTextFormatsRoundTrip checks that writing random graphs in every text
+// format and reading them back gives the same graph6, loop6 or sparse6
+// encodings.
+func TestTextFormatsRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(50))
+	for k := 0; k < 50; k++ {
+		g, _ := generators.GnpRandomGraph(1+r.Intn(12), r.Float64(), r)
+		expected := ToGraph6(g)
+		readers := map[string]func() (*StaticGraph, error){
+			"edge list":          func() (*StaticGraph, error) { return FromEdgeList(ToEdgeList(g, false)) },
+			"weighted edge list": func() (*StaticGraph, error) { return FromEdgeList(ToEdgeList(g, true)) },
+			"DIMACS":             func() (*StaticGraph, error) { return FromDIMACS(ToDIMACS(g)) },
+			"METIS": func() (*StaticGraph, error) {
+				s, _ := ToMETIS(g, false)
+				return FromMETIS(s)
+			},
+			"weighted METIS": func() (*StaticGraph, error) {
+				s, _ := ToMETIS(g, true)
+				return FromMETIS(s)
+			},
+			"showg": func() (*StaticGraph, error) {
+				graphs, err := FromShowg(ToShowg(g))
+				if err != nil {
+					return nil, err
+				}
+				return graphs[0], nil
+			},
+		}
+		for name, read := range readers {
+			h, err := read()
+			if err != nil {
+				t.Errorf("Expected no error reading the %v format of %v, got %v", name, expected, err)
+			} else if got := ToGraph6(h); got != expected {
+				t.Errorf("Expected %v from the %v format, got %v", expected, name, got)
+			}
+		}
+
+		m := randomMultigraph(r, 3+r.Intn(8))
+		expected = ToSparse6(m)
+		for _, weighted := range []bool{false, true} {
+			h, err := FromEdgeList(ToEdgeList(m, weighted))
+			if err != nil || ToSparse6(h) != expected {
+				t.Errorf("Expected %v from the edge list format (weighted: %v)", expected, weighted)
+			}
+		}
+		var simple []graph.Edge
+		for _, e := range m.Edges() {
+			simple = append(simple, graph.Edge{U: e.U, V: e.V, Multiplicity: 1})
+		}
+		underlying, _ := graph.NewGraphFromEdges(m.Order(), simple)
+		expected = ToLoop6(underlying)
+		if h, err := FromDIMACS(ToDIMACS(m)); err != nil || ToLoop6(h) != expected {
+			t.Errorf("Expected %v from the DIMACS format", expected)
+		}
+		if graphs, err := FromShowg(ToShowg(m, g)); err != nil || len(graphs) != 2 ||
+			ToLoop6(graphs[0]) != expected || ToGraph6(graphs[1]) != ToGraph6(g) {
+			t.Errorf("Expected %v and %v from the showg format", expected, ToGraph6(g))
+		}
+	}
+}
+
+// TestFromTextFormats reads some graphs written by hand, with comments, and
+// features of the formats not produced by the writers.
+func TestFromTextFormats(t *testing.T) {
+	petersen := "IheA@GUAo"
+
+	edgeList := "# The cycle of order 4 with a double edge.\n4 4\n0 1\n1 2 2\n2 3\n% last\n3 0\n"
+	g, err := FromEdgeList(edgeList)
+	expected := [][]byte{{0, 1, 0, 1}, {1, 0, 2, 0}, {0, 2, 0, 1}, {1, 0, 1, 0}}
+	if got, _ := g.Matrix(); err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	dimacs := "c The Petersen graph, with every edge in both directions.\np col 10 30\n"
+	for _, e := range FromGraph6(petersen).Edges() {
+		dimacs += "e " + strconv.Itoa(e.U+1) + " " + strconv.Itoa(e.V+1) + "\ne " + strconv.Itoa(e.V+1) + " " + strconv.Itoa(e.U+1) + "\n"
+	}
+	if g, err := FromDIMACS(dimacs); err != nil || ToGraph6(g) != petersen {
+		t.Errorf("Expected the Petersen graph from %v", dimacs)
+	}
+
+	metis := "% The path of order 3 with vertex sizes and two vertex weights.\n" +
+		"3 2 111 2\n" +
+		"1 5 6 2 3\n" +
+		"1 5 6 1 3 3 4\n" +
+		"1 5 6 2 4\n"
+	g, err = FromMETIS(metis)
+	expected = [][]byte{{0, 3, 0}, {3, 0, 4}, {0, 4, 0}}
+	if got, _ := g.Matrix(); err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if g, err := FromMETIS("3 1\n2\n1\n\n"); err != nil || g.Order() != 3 || g.Size() != 1 {
+		t.Errorf("Expected an edge and an isolated vertex from METIS, got %v", err)
+	}
+
+	showg := "\nGraph 1, order 3.\n  0 : 1\n 2;\n  1 : 0;\n  2 : 0;\n\nGraph 2, order 0.\n"
+	graphs, err := FromShowg(showg)
+	if err != nil || len(graphs) != 2 || ToGraph6(graphs[0]) != "Bo" || graphs[1].Order() != 0 {
+		t.Errorf("Expected a path of order 3 and the empty graph from %v", showg)
+	}
+}
+
+// TestTextFormatErrors checks that invalid texts are rejected.
+func TestTextFormatErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		read     func() error
+		expected error
+	}{
+		{"edge list without header", func() error { _, err := FromEdgeList(""); return err }, MalformedInput},
+		{"edge list with few edges", func() error { _, err := FromEdgeList("3 2\n0 1\n"); return err }, EdgeCountMismatch},
+		{"edge list with a bad vertex", func() error { _, err := FromEdgeList("3 1\n0 3\n"); return err }, graph.InvalidEdge},
+		{"edge list with a bad weight", func() error { _, err := FromEdgeList("3 1\n0 1 0\n"); return err }, graph.InvalidEdge},
+		{"edge list with a bad line", func() error { _, err := FromEdgeList("3 1\n0 x\n"); return err }, MalformedInput},
+		{"DIMACS without problem", func() error { _, err := FromDIMACS("e 1 2\n"); return err }, MalformedInput},
+		{"DIMACS with a bad vertex", func() error { _, err := FromDIMACS("p edge 2 1\ne 1 3\n"); return err }, graph.InvalidEdge},
+		{"METIS with asymmetry", func() error { _, err := FromMETIS("2 1\n2\n\n"); return err }, AsymmetricAdjacency},
+		{"METIS with a loop", func() error { _, err := FromMETIS("1 1\n1\n"); return err }, graph.InvalidEdge},
+		{"METIS with few edges", func() error { _, err := FromMETIS("2 2\n2\n1\n"); return err }, EdgeCountMismatch},
+		{"METIS with extra lines", func() error { _, err := FromMETIS("1 0\n\n1\n"); return err }, MalformedInput},
+		{"METIS with a bad code", func() error { _, err := FromMETIS("1 0 2\n\n"); return err }, MalformedInput},
+		{"showg with asymmetry", func() error { _, err := FromShowg("Graph 1, order 2.\n 0 : 1;\n 1 :;\n"); return err }, AsymmetricAdjacency},
+		{"showg with few vertices", func() error { _, err := FromShowg("Graph 1, order 2.\n 0 :;\n"); return err }, MalformedInput},
+		{"showg without title", func() error { _, err := FromShowg(" 0 :;\n"); return err }, MalformedInput},
+	}
+	for _, c := range cases {
+		if err := c.read(); err != c.expected {
+			t.Errorf("Expected %v for the %v, got %v", c.expected, c.name, err)
+		}
+	}
+	loop, _ := graph.NewGraphFromEdges(1, []graph.Edge{{U: 0, V: 0, Multiplicity: 1}})
+	if _, err := ToMETIS(loop, false); err != LoopsNotSupported {
+		t.Errorf("Expected %v, got %v", LoopsNotSupported, err)
+	}
+}
@@ -0,0 +1,250 @@
+package generators
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// A FiniteGroup is a finite group modelled by its multiplication table. The
+// elements of a group of order n are the integers in [0, n), and 0 is its
+// identity.
+type FiniteGroup struct {
+	table   [][]int
+	inverse []int
+}
+
+// NewGroupFromTable initializes a group from its multiplication table, where
+// table[a][b] is the product of a and b. This method checks whether the table
+// describes a group with identity 0; if it does not, it throws an error.
+func NewGroupFromTable(table [][]int) (*FiniteGroup, error) {
+	n := len(table)
+	for a := range table {
+		if len(table[a]) != n || table[0][a] != a || table[a][0] != a {
+			return nil, InvalidGroupTable
+		}
+		seen := make([]bool, n)
+		for _, c := range table[a] {
+			if c < 0 || c >= n || seen[c] {
+				return nil, InvalidGroupTable
+			}
+			seen[c] = true
+		}
+	}
+	for a := range table {
+		for b := range table {
+			for c := range table {
+				if table[table[a][b]][c] != table[a][table[b][c]] {
+					return nil, InvalidGroupTable
+				}
+			}
+		}
+	}
+	return newGroup(table), nil
+}
+
+// Initializes a group from a multiplication table known to be valid.
+func newGroup(table [][]int) *FiniteGroup {
+	inverse := make([]int, len(table))
+	for a := range table {
+		for b, c := range table[a] {
+			if c == 0 {
+				inverse[a] = b
+			}
+		}
+	}
+	return &FiniteGroup{
+		table:   table,
+		inverse: inverse,
+	}
+}
+
+// Order returns the number of elements of the group.
+func (g *FiniteGroup) Order() int {
+	return len(g.table)
+}
+
+// Multiply returns the product of two elements of the group.
+func (g *FiniteGroup) Multiply(a, b int) int {
+	return g.table[a][b]
+}
+
+// Inverse returns the inverse of an element of the group.
+func (g *FiniteGroup) Inverse(a int) int {
+	return g.inverse[a]
+}
+
+// CyclicGroup returns the cyclic group of order n, the integers modulo n under
+// addition.
+func CyclicGroup(n int) *FiniteGroup {
+	table := make([][]int, n)
+	for a := range table {
+		table[a] = make([]int, n)
+		for b := range table[a] {
+			table[a][b] = (a + b) % n
+		}
+	}
+	return newGroup(table)
+}
+
+// DihedralGroup returns the dihedral group of order 2n, the symmetries of a
+// regular polygon with n sides. The element r^i s^j, where r is a rotation and
+// s is a reflection, is represented by i + n*j.
+func DihedralGroup(n int) *FiniteGroup {
+	table := make([][]int, 2*n)
+	for a := range table {
+		table[a] = make([]int, 2*n)
+		i, j := a%n, a/n
+		for b := range table[a] {
+			k, l := b%n, b/n
+			if j == 1 {
+				k = n - k
+			}
+			table[a][b] = (i+k)%n + n*((j+l)%2)
+		}
+	}
+	return newGroup(table)
+}
+
+// Returns the permutations of {0, ..., n-1} in lexicographic order.
+func permutations(n int) [][]int {
+	var perms [][]int
+	current := make([]int, 0, n)
+	used := make([]bool, n)
+	var extend func()
+	extend = func() {
+		if len(current) == n {
+			p := make([]int, n)
+			copy(p, current)
+			perms = append(perms, p)
+			return
+		}
+		for i := 0; i < n; i++ {
+			if !used[i] {
+				used[i] = true
+				current = append(current, i)
+				extend()
+				current = current[:len(current)-1]
+				used[i] = false
+			}
+		}
+	}
+	extend()
+	return perms
+}
+
+// SymmetricGroup returns the symmetric group on n points. Its elements are the
+// permutations of {0, ..., n-1} in lexicographic order, so 0 is the identity,
+// and the product of a and b is the permutation applying a first and then b.
+func SymmetricGroup(n int) *FiniteGroup {
+	perms := permutations(n)
+	index := make(map[string]int)
+	for i, p := range perms {
+		index[string(intsToBytes(p))] = i
+	}
+	table := make([][]int, len(perms))
+	for a, p := range perms {
+		table[a] = make([]int, len(perms))
+		for b, q := range perms {
+			r := make([]int, n)
+			for x := range r {
+				r[x] = q[p[x]]
+			}
+			table[a][b] = index[string(intsToBytes(r))]
+		}
+	}
+	return newGroup(table)
+}
+
+// Converts a slice of small non-negative integers into a byte slice.
+func intsToBytes(s []int) []byte {
+	b := make([]byte, len(s))
+	for i, v := range s {
+		b[i] = byte(v)
+	}
+	return b
+}
+
+// DirectProduct returns the direct product of two groups. The pair (a,b) is
+// represented by a*m + b, where m is the order of the second group.
+func DirectProduct(g, h *FiniteGroup) *FiniteGroup {
+	m := h.Order()
+	table := make([][]int, g.Order()*m)
+	for x := range table {
+		table[x] = make([]int, len(table))
+		for y := range table[x] {
+			table[x][y] = g.Multiply(x/m, y/m)*m + h.Multiply(x%m, y%m)
+		}
+	}
+	return newGroup(table)
+}
+
+// Makes the adjacency matrix of the Cayley digraph of a group with respect to
+// a set of elements. If symmetric is true, the inverse of every element is
+// also considered, obtaining a Cayley graph.
+func cayleyMatrix(g *FiniteGroup, generators []int, symmetric bool) (graph.AdjacencyMatrix, error) {
+	for _, s := range generators {
+		if s < 0 || s >= g.Order() {
+			return nil, InvalidElement
+		}
+	}
+	a := emptyMatrix(g.Order())
+	for _, s := range generators {
+		if s == 0 {
+			continue
+		}
+		for x := range a {
+			a[x][g.Multiply(x, s)] = 1
+			if symmetric {
+				a[g.Multiply(x, s)][x] = 1
+			}
+		}
+	}
+	return a, nil
+}
+
+// CayleyMatrixDigraph returns the Cayley digraph of a group with respect to a
+// set of elements, modelled by an adjacency matrix. Its vertices are the
+// elements of the group, and there is an arc from x to xs for every element s
+// of the set other than the identity.
+func CayleyMatrixDigraph(g *FiniteGroup, generators []int) (*StaticDigraph, error) {
+	a, err := cayleyMatrix(g, generators, false)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewDigraphFromMatrix(a), nil
+}
+
+// CayleyListDigraph returns the Cayley digraph of a group with respect to a set
+// of elements, modelled by an adjacency list. Its vertices are the elements of
+// the group, and there is an arc from x to xs for every element s of the set
+// other than the identity.
+func CayleyListDigraph(g *FiniteGroup, generators []int) (*StaticDigraph, error) {
+	a, err := cayleyMatrix(g, generators, false)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewDigraphFromList(matrixToList(a)), nil
+}
+
+// CayleyMatrixGraph returns the Cayley graph of a group with respect to a set
+// of elements, modelled by an adjacency matrix. Its vertices are the elements
+// of the group, and x is adjacent to xs and to xs^-1 for every element s of
+// the set other than the identity.
+func CayleyMatrixGraph(g *FiniteGroup, generators []int) (*StaticGraph, error) {
+	a, err := cayleyMatrix(g, generators, true)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// CayleyListGraph returns the Cayley graph of a group with respect to a set of
+// elements, modelled by an adjacency list. Its vertices are the elements of
+// the group, and x is adjacent to xs and to xs^-1 for every element s of the
+// set other than the identity.
+func CayleyListGraph(g *FiniteGroup, generators []int) (*StaticGraph, error) {
+	a, err := cayleyMatrix(g, generators, true)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
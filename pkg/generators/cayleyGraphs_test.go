@@ -0,0 +1,98 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Checks that a group satisfies the group axioms by rebuilding it from its
+// multiplication table.
+func checkGroup(t *testing.T, g *FiniteGroup, order int) {
+	if g.Order() != order {
+		t.Errorf("Expected order %v, got %v", order, g.Order())
+	}
+	table := make([][]int, g.Order())
+	for a := range table {
+		table[a] = make([]int, g.Order())
+		for b := range table[a] {
+			table[a][b] = g.Multiply(a, b)
+		}
+		if g.Multiply(a, g.Inverse(a)) != 0 {
+			t.Errorf("%v is not the inverse of %v", g.Inverse(a), a)
+		}
+	}
+	if _, err := NewGroupFromTable(table); err != nil {
+		t.Errorf("Didn't expect an error, got %v", err)
+	}
+}
+
+// TestGroups checks the cyclic, dihedral, symmetric and direct product
+// groups, and that invalid multiplication tables are rejected.
+func TestGroups(t *testing.T) {
+	checkGroup(t, CyclicGroup(7), 7)
+	checkGroup(t, DihedralGroup(5), 10)
+	checkGroup(t, SymmetricGroup(4), 24)
+	checkGroup(t, DirectProduct(CyclicGroup(2), DihedralGroup(3)), 12)
+
+	// The dihedral group of order 6 is not abelian.
+	d := DihedralGroup(3)
+	if d.Multiply(1, 3) == d.Multiply(3, 1) {
+		t.Errorf("Rotation and reflection are not expected to commute")
+	}
+	invalid := [][][]int{
+		{{0, 1}, {1, 1}},
+		{{1, 0}, {0, 1}},
+		{{0, 1, 2}, {1, 0, 2}, {2, 1, 0}},
+		{{0, 1, 2, 3, 4}, {1, 0, 3, 4, 2}, {2, 4, 0, 1, 3}, {3, 2, 4, 0, 1},
+			{4, 3, 1, 2, 0}},
+	}
+	for _, table := range invalid {
+		if _, err := NewGroupFromTable(table); err != InvalidGroupTable {
+			t.Errorf("Expected %v, got %v", InvalidGroupTable, err)
+		}
+	}
+}
+
+// TestCayleyGraphs compares Cayley graphs of cyclic groups with circulant
+// graphs, the Cayley graph of the elementary abelian group of order 8 with
+// the hypercube Q3, and the Cayley graph of the symmetric group on three
+// points generated by two transpositions with a cycle of order 6.
+func TestCayleyGraphs(t *testing.T) {
+	jumps := []int{1, 3, 4}
+	c, _ := CayleyMatrixDigraph(CyclicGroup(9), jumps)
+	a, _ := c.Matrix()
+	b, _ := CirculantMatrixDigraph(9, jumps).Matrix()
+	if !sliceutils.EqualByteMatrix(a, b) {
+		t.Errorf("Expected %v, got %v", b, a)
+	}
+	g, _ := CayleyListGraph(CyclicGroup(9), jumps)
+	a = graph.MatrixOf(g)
+	b, _ = CirculantMatrixGraph(9, jumps).Matrix()
+	if !sliceutils.EqualByteMatrix(a, b) {
+		t.Errorf("Expected %v, got %v", b, a)
+	}
+
+	z2 := CyclicGroup(2)
+	g, _ = CayleyMatrixGraph(DirectProduct(z2, DirectProduct(z2, z2)), []int{1, 2, 4})
+	a, _ = g.Matrix()
+	b, _ = HypercubeMatrixGraph(3).Matrix()
+	if !sliceutils.EqualByteMatrix(a, b) {
+		t.Errorf("Expected %v, got %v", b, a)
+	}
+
+	// In lexicographic order, (0 2 1) and (1 0 2) are the permutations 1 and 2.
+	s3 := SymmetricGroup(3)
+	g, _ = CayleyMatrixGraph(s3, []int{1, 2})
+	if !IsCycle(g) {
+		t.Errorf("Expected a cycle, got %v", graph.MatrixOf(g))
+	}
+	d, _ := CayleyListDigraph(DihedralGroup(4), []int{1})
+	if d.Size() != 8 || IsDirectedCycle(d) || !IsCirculant(d) {
+		t.Errorf("Expected two disjoint directed cycles of order 4, as C8(2)")
+	}
+	if _, err := CayleyMatrixGraph(s3, []int{6}); err != InvalidElement {
+		t.Errorf("Expected %v, got %v", InvalidElement, err)
+	}
+}
@@ -0,0 +1,258 @@
+package generators
+
+import (
+	"sort"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// A sequence of non-negative integers is graphic if it is the degree sequence
+// of a simple graph, listing the degree of every vertex, and a pair of
+// sequences is digraphic if they are the out-degrees and in-degrees of the
+// vertices of a digraph without loops or parallel arcs.
+
+// Returns a copy of a sequence in non-increasing order.
+func nonIncreasing(degrees []int) []int {
+	sorted := append([]int(nil), degrees...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	return sorted
+}
+
+// ErdosGallai returns whether a sequence is graphic, using the theorem of
+// Erdős and Gallai: a non-increasing sequence d of non-negative integers with
+// an even sum is graphic if and only if, for every k,
+// d[0] + ... + d[k-1] <= k(k-1) + min(d[k], k) + ... + min(d[n-1], k).
+func ErdosGallai(degrees []int) bool {
+	d := nonIncreasing(degrees)
+	sum := 0
+	for _, x := range d {
+		if x < 0 {
+			return false
+		}
+		sum += x
+	}
+	if sum%2 != 0 {
+		return false
+	}
+	left := 0
+	for k := 1; k <= len(d); k++ {
+		left += d[k-1]
+		right := k * (k - 1)
+		for _, x := range d[k:] {
+			if x < k {
+				right += x
+			} else {
+				right += k
+			}
+		}
+		if left > right {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the edges of a realisation of a sequence built by the algorithm of
+// Havel and Hakimi, which repeatedly joins a vertex of greatest remaining
+// degree to the vertices of greatest remaining degree after it, or nil and
+// false if the sequence is not graphic.
+func havelHakimi(degrees []int) ([][2]int, bool) {
+	n := len(degrees)
+	remaining := append([]int(nil), degrees...)
+	vertices := make([]int, n)
+	for v := range vertices {
+		if remaining[v] < 0 {
+			return nil, false
+		}
+		vertices[v] = v
+	}
+	var edges [][2]int
+	for len(vertices) > 0 {
+		sort.SliceStable(vertices, func(i, j int) bool {
+			return remaining[vertices[i]] > remaining[vertices[j]]
+		})
+		v := vertices[0]
+		vertices = vertices[1:]
+		if remaining[v] > len(vertices) {
+			return nil, false
+		}
+		for _, w := range vertices[:remaining[v]] {
+			if remaining[w] == 0 {
+				return nil, false
+			}
+			remaining[w]--
+			edges = append(edges, [2]int{v, w})
+		}
+		remaining[v] = 0
+	}
+	return edges, true
+}
+
+// HavelHakimi returns whether a sequence is graphic, using the algorithm of
+// Havel and Hakimi.
+func HavelHakimi(degrees []int) bool {
+	_, graphic := havelHakimi(degrees)
+	return graphic
+}
+
+// RealiseDegreeSequence returns a graph where every vertex v has degree
+// degrees[v], built with the algorithm of Havel and Hakimi, and modelled by an
+// adjacency matrix. If the sequence is not graphic, an error is returned.
+func RealiseDegreeSequence(degrees []int) (*StaticGraph, error) {
+	edges, graphic := havelHakimi(degrees)
+	if !graphic {
+		return nil, NotGraphic
+	}
+	a := emptyMatrix(len(degrees))
+	for _, e := range edges {
+		addEdge(a, e[0], e[1])
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// DegreeSequenceRealisations calls f with every realisation of a sequence, a
+// graph where every vertex v has degree degrees[v], up to isomorphism. The
+// realisations are modelled by adjacency matrices. Every two realisations are
+// related by a sequence of 2-switches, which replace edges ab and cd with ac
+// and bd, so the search goes through the realisations reachable from the one
+// of Havel and Hakimi by 2-switches, discarding isomorphic ones. If the
+// sequence is not graphic, an error is returned.
+func DegreeSequenceRealisations(degrees []int, f func(*StaticGraph)) error {
+	first, err := RealiseDegreeSequence(degrees)
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{isomorphism.Certificate(first): true}
+	queue := []graph.AdjacencyMatrix{graph.MatrixOf(first)}
+	for len(queue) > 0 {
+		a := queue[0]
+		queue = queue[1:]
+		f(graph.NewFromMatrix(a))
+		var edges [][2]int
+		for u := range a {
+			for v := u + 1; v < len(a); v++ {
+				if a[u][v] != 0 {
+					edges = append(edges, [2]int{u, v}, [2]int{v, u})
+				}
+			}
+		}
+		for i, e := range edges {
+			for _, h := range edges[i+1:] {
+				x, y, z, w := e[0], e[1], h[0], h[1]
+				if x == z || x == w || y == z || y == w || a[x][z] != 0 || a[y][w] != 0 {
+					continue
+				}
+				b := make([][]byte, len(a))
+				for v := range a {
+					b[v] = append([]byte(nil), a[v]...)
+				}
+				b[x][y], b[y][x], b[z][w], b[w][z] = 0, 0, 0, 0
+				addEdge(b, x, z)
+				addEdge(b, y, w)
+				cert := isomorphism.Certificate(graph.NewFromMatrix(b))
+				if !seen[cert] {
+					seen[cert] = true
+					queue = append(queue, b)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the indices of two sequences sorted lexicographically in
+// non-increasing order of the pairs (out[v], in[v]).
+func lexicographicOrder(out, in []int) []int {
+	order := make([]int, len(out))
+	for v := range order {
+		order[v] = v
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		u, v := order[i], order[j]
+		return out[u] > out[v] || (out[u] == out[v] && in[u] > in[v])
+	})
+	return order
+}
+
+// IsDigraphic returns whether two sequences are the out-degrees and in-degrees
+// of a digraph, where vertex v has out-degree out[v] and in-degree in[v],
+// using the theorem of Fulkerson, Chen and Anstee: if the pairs are sorted
+// lexicographically in non-increasing order, the sums of both sequences are
+// equal and, for every k,
+// out[0] + ... + out[k-1] <= min(in[0], k-1) + ... + min(in[k-1], k-1) +
+// min(in[k], k) + ... + min(in[n-1], k).
+func IsDigraphic(out, in []int) bool {
+	if len(out) != len(in) {
+		return false
+	}
+	difference := 0
+	for v := range out {
+		if out[v] < 0 || in[v] < 0 {
+			return false
+		}
+		difference += out[v] - in[v]
+	}
+	if difference != 0 {
+		return false
+	}
+	order := lexicographicOrder(out, in)
+	left := 0
+	for k := 1; k <= len(order); k++ {
+		left += out[order[k-1]]
+		right := 0
+		for i, v := range order {
+			bound := k
+			if i < k {
+				bound = k - 1
+			}
+			if in[v] < bound {
+				right += in[v]
+			} else {
+				right += bound
+			}
+		}
+		if left > right {
+			return false
+		}
+	}
+	return true
+}
+
+// RealiseDigraphicSequences returns a digraph without loops or parallel arcs
+// where vertex v has out-degree out[v] and in-degree in[v], modelled by an
+// adjacency matrix. It uses the algorithm of Kleitman and Wang, which
+// repeatedly joins a vertex to the other vertices with the greatest remaining
+// in-degrees, breaking ties by the greatest remaining out-degrees. If the
+// sequences are not digraphic, an error is returned.
+func RealiseDigraphicSequences(out, in []int) (*StaticDigraph, error) {
+	if !IsDigraphic(out, in) {
+		return nil, NotDigraphic
+	}
+	n := len(out)
+	remainingOut := append([]int(nil), out...)
+	remainingIn := append([]int(nil), in...)
+	a := emptyMatrix(n)
+	for v := 0; v < n; v++ {
+		others := make([]int, 0, n-1)
+		for w := 0; w < n; w++ {
+			if w != v {
+				others = append(others, w)
+			}
+		}
+		sort.SliceStable(others, func(i, j int) bool {
+			x, y := others[i], others[j]
+			return remainingIn[x] > remainingIn[y] ||
+				(remainingIn[x] == remainingIn[y] && remainingOut[x] > remainingOut[y])
+		})
+		for _, w := range others[:remainingOut[v]] {
+			if remainingIn[w] == 0 {
+				return nil, NotDigraphic
+			}
+			a[v][w] = 1
+			remainingIn[w]--
+		}
+		remainingOut[v] = 0
+	}
+	return graph.NewDigraphFromMatrix(a), nil
+}
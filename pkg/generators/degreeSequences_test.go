@@ -0,0 +1,129 @@
+package generators
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns the out-degrees and in-degrees of the vertices of a matrix.
+func outInDegrees(a graph.AdjacencyMatrix) ([]int, []int) {
+	out, in := make([]int, len(a)), make([]int, len(a))
+	for u := range a {
+		for v := range a {
+			out[u] += int(a[u][v])
+			in[v] += int(a[u][v])
+		}
+	}
+	return out, in
+}
+
+// Calls f with every sequence of length n with entries between 0 and n-1.
+func forEachSequence(n int, f func([]int)) {
+	s := make([]int, n)
+	var fill func(i int)
+	fill = func(i int) {
+		if i == n {
+			f(s)
+			return
+		}
+		for x := 0; x < n; x++ {
+			s[i] = x
+			fill(i + 1)
+		}
+	}
+	fill(0)
+}
+
+// TestGraphicSequences compares both graphicality tests with the degree
+// sequences of every graph with at most 6 vertices, checks the realisations,
+// and counts the realisations of every sequence.
+func TestGraphicSequences(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		realisations := make(map[string]int)
+		Graphs(n, false, func(g *StaticGraph) {
+			d, _ := outInDegrees(graph.MatrixOf(g))
+			realisations[fmt.Sprint(nonIncreasing(d))]++
+		})
+		forEachSequence(n, func(s []int) {
+			expected := realisations[fmt.Sprint(nonIncreasing(s))]
+			if ErdosGallai(s) != (expected > 0) || HavelHakimi(s) != (expected > 0) {
+				t.Errorf("Expected %v to be graphic: %v", s, expected > 0)
+			}
+			g, err := RealiseDegreeSequence(s)
+			if expected == 0 {
+				if err != NotGraphic {
+					t.Errorf("Expected %v, got %v", NotGraphic, err)
+				}
+				return
+			}
+			a := graph.MatrixOf(g)
+			if d, _ := outInDegrees(a); fmt.Sprint(d) != fmt.Sprint(s) || !isSimple(a) {
+				t.Errorf("Expected a realisation of %v, got %v", s, a)
+			}
+			count := 0
+			DegreeSequenceRealisations(s, func(h *StaticGraph) {
+				count++
+				if d, _ := outInDegrees(graph.MatrixOf(h)); fmt.Sprint(d) != fmt.Sprint(s) {
+					t.Errorf("Expected a realisation of %v, got %v", s, d)
+				}
+			})
+			if count != expected {
+				t.Errorf("Expected %v realisations of %v, got %v", expected, s, count)
+			}
+		})
+	}
+	if ErdosGallai([]int{3, 3, -1, 1}) || ErdosGallai([]int{3, 3, 3}) || HavelHakimi([]int{1}) {
+		t.Errorf("Expected the sequences not to be graphic")
+	}
+}
+
+// TestDigraphicSequences compares the Fulkerson–Chen–Anstee test with the
+// degrees of every digraph with at most 4 vertices, and checks the
+// realisations.
+func TestDigraphicSequences(t *testing.T) {
+	for n := 1; n <= 4; n++ {
+		digraphic := make(map[string]bool)
+		Digraphs(n, false, func(d *StaticDigraph) {
+			out, in := outInDegrees(graph.MatrixOf(d))
+			order := lexicographicOrder(out, in)
+			pairs := make([][2]int, n)
+			for i, v := range order {
+				pairs[i] = [2]int{out[v], in[v]}
+			}
+			digraphic[fmt.Sprint(pairs)] = true
+		})
+		forEachSequence(n, func(out []int) {
+			out = append([]int(nil), out...)
+			forEachSequence(n, func(in []int) {
+				order := lexicographicOrder(out, in)
+				pairs := make([][2]int, n)
+				for i, v := range order {
+					pairs[i] = [2]int{out[v], in[v]}
+				}
+				expected := digraphic[fmt.Sprint(pairs)]
+				if IsDigraphic(out, in) != expected {
+					t.Errorf("Expected %v and %v to be digraphic: %v", out, in, expected)
+				}
+				d, err := RealiseDigraphicSequences(out, in)
+				if !expected {
+					if err != NotDigraphic {
+						t.Errorf("Expected %v, got %v", NotDigraphic, err)
+					}
+					return
+				}
+				a := graph.MatrixOf(d)
+				o, i := outInDegrees(a)
+				for v := range a {
+					if a[v][v] != 0 {
+						t.Errorf("Unexpected loop at %v", v)
+					}
+				}
+				if fmt.Sprint(o) != fmt.Sprint(out) || fmt.Sprint(i) != fmt.Sprint(in) {
+					t.Errorf("Expected a realisation of %v and %v, got %v", out, in, a)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package generators
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// The exhaustive generators use the canonical augmentation of McKay: every
+// graph of order m+1 is obtained from a graph of order m by adding a vertex,
+// and it is kept only if the added vertex could be the canonical last one,
+// that is, if deleting it leaves a graph isomorphic to the one obtained by
+// deleting the last vertex of its canonical labelling. Since the graphs of
+// each order are pairwise non-isomorphic, every isomorphism class has a
+// unique parent, so discarding isomorphic children of the same parent is
+// enough to avoid repetitions.
+
+// Returns the matrix obtained by adding a vertex to a matrix, where out[v] and
+// in[v] are the entries of the arcs from the new vertex to v and from v to the
+// new vertex.
+func addVertex(a graph.AdjacencyMatrix, out, in []byte) graph.AdjacencyMatrix {
+	m := len(a)
+	b := make([][]byte, m+1)
+	for i := range a {
+		b[i] = make([]byte, m+1)
+		copy(b[i], a[i])
+		b[i][m] = in[i]
+	}
+	b[m] = make([]byte, m+1)
+	copy(b[m], out)
+	return b
+}
+
+// Returns the certificate of a graph obtained by adding its last vertex to a
+// parent with a given certificate, and whether the graph must be kept. The
+// certificates of the children already considered are in seen. If colours is
+// not nil, the vertices are coloured and isomorphisms must preserve colours;
+// the last vertex must have the greatest colour.
+func canonicalAugmentation(a graph.AdjacencyMatrix, colours []int, parent string, seen map[string]bool) (string, bool) {
+	g := graph.NewFromMatrix(a)
+	var labels []int
+	var cert string
+	if colours == nil {
+		labels, cert = isomorphism.Canonize(g)
+	} else {
+		labels, cert, _ = isomorphism.ColouredCanonize(g, colours)
+	}
+	if seen[cert] {
+		return cert, false
+	}
+	seen[cert] = true
+	last := len(a) - 1
+	for v, l := range labels {
+		if l == last {
+			if v == last {
+				return cert, true
+			}
+			// Deleting vertices with different total degree leaves graphs
+			// of different sizes.
+			dv, dl := 0, 0
+			for i := range a {
+				dv += int(a[v][i]) + int(a[i][v])
+				dl += int(a[last][i]) + int(a[i][last])
+			}
+			if dv != dl {
+				return cert, false
+			}
+			h := graph.NewFromMatrix(deleteVertex(a, v))
+			if colours == nil {
+				return cert, isomorphism.Certificate(h) == parent
+			}
+			// The canonical last vertex has the greatest colour, like the
+			// last vertex, so the remaining colours are the same.
+			hCert, _ := isomorphism.ColouredCertificate(h, colours[:last])
+			return cert, hCert == parent
+		}
+	}
+	return cert, false
+}
+
+// Returns whether the graph or digraph with a given matrix is connected,
+// ignoring the directions of the arcs.
+func isConnected(a graph.AdjacencyMatrix) bool {
+	if len(a) == 0 {
+		return true
+	}
+	seen := make([]bool, len(a))
+	seen[0] = true
+	stack := []int{0}
+	count := 1
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for w := range a {
+			if !seen[w] && (a[v][w] != 0 || a[w][v] != 0) {
+				seen[w] = true
+				count++
+				stack = append(stack, w)
+			}
+		}
+	}
+	return count == len(a)
+}
+
+// The parameters of the generation of graphs with bounded degrees.
+type degreeBoundedSearch struct {
+	n, minDegree, maxDegree int
+	connected               bool
+	f                       func(*StaticGraph)
+}
+
+// Generates the descendants of a graph with a given degree of every vertex and
+// a given certificate. A vertex whose degree is too small to reach the minimum
+// degree with the vertices still to be added must be adjacent to the new
+// vertex.
+func (s *degreeBoundedSearch) extend(a graph.AdjacencyMatrix, degrees []int, cert string) {
+	m := len(a)
+	if m == s.n {
+		if !s.connected || isConnected(a) {
+			s.f(graph.NewFromMatrix(a))
+		}
+		return
+	}
+	remaining := s.n - m - 1
+	seen := make(map[string]bool)
+	row := make([]byte, m+1)
+	var choose func(v, d int)
+	choose = func(v, d int) {
+		if v == m {
+			if d+remaining < s.minDegree {
+				return
+			}
+			child := addVertex(a, row, row)
+			childCert, ok := canonicalAugmentation(child, nil, cert, seen)
+			if !ok {
+				return
+			}
+			childDegrees := make([]int, m+1)
+			for w := range a {
+				childDegrees[w] = degrees[w] + int(row[w])
+			}
+			childDegrees[m] = d
+			s.extend(child, childDegrees, childCert)
+			return
+		}
+		if degrees[v]+remaining >= s.minDegree {
+			row[v] = 0
+			choose(v+1, d)
+		}
+		if d < s.maxDegree && degrees[v] < s.maxDegree && degrees[v]+1+remaining >= s.minDegree {
+			row[v] = 1
+			choose(v+1, d+1)
+			row[v] = 0
+		}
+	}
+	choose(0, 0)
+}
+
+// BoundedDegreeGraphs calls f with every graph of order n whose degrees are
+// between minDegree and maxDegree, up to isomorphism. If connected is true,
+// only connected graphs are generated. The degree bounds are enforced while
+// the graphs are built, so this is much faster than filtering all the graphs.
+// The graphs are modelled by adjacency matrices. If the parameters are
+// negative or minDegree > maxDegree, an error is returned.
+func BoundedDegreeGraphs(n, minDegree, maxDegree int, connected bool, f func(*StaticGraph)) error {
+	if n < 0 || minDegree < 0 || minDegree > maxDegree {
+		return InvalidParameters
+	}
+	s := &degreeBoundedSearch{
+		n:         n,
+		minDegree: minDegree,
+		maxDegree: maxDegree,
+		connected: connected,
+		f:         f,
+	}
+	empty := emptyMatrix(0)
+	s.extend(empty, nil, isomorphism.Certificate(graph.NewFromMatrix(empty)))
+	return nil
+}
+
+// Graphs calls f with every graph of order n, up to isomorphism, in the
+// manner of geng. If connected is true, only connected graphs are generated.
+// The graphs are modelled by adjacency matrices.
+func Graphs(n int, connected bool, f func(*StaticGraph)) {
+	if n >= 0 {
+		BoundedDegreeGraphs(n, 0, n, connected, f)
+	}
+}
+
+// RegularGraphs calls f with every k-regular graph of order n, up to
+// isomorphism. If connected is true, only connected graphs are generated. The
+// graphs are modelled by adjacency matrices. If n or k are negative, an error
+// is returned.
+func RegularGraphs(n, k int, connected bool, f func(*StaticGraph)) error {
+	return BoundedDegreeGraphs(n, k, k, connected, f)
+}
+
+// The parameters of the generation of digraphs, where options holds the
+// allowed pairs of entries (a[u][v], a[v][u]) between two vertices u and v.
+type digraphSearch struct {
+	n         int
+	options   [][2]byte
+	connected bool
+	f         func(*StaticDigraph)
+}
+
+// Generates the descendants of a digraph with a given certificate.
+func (s *digraphSearch) extend(a graph.AdjacencyMatrix, cert string) {
+	m := len(a)
+	if m == s.n {
+		if !s.connected || isConnected(a) {
+			s.f(graph.NewDigraphFromMatrix(a))
+		}
+		return
+	}
+	seen := make(map[string]bool)
+	out := make([]byte, m+1)
+	in := make([]byte, m+1)
+	var choose func(v int)
+	choose = func(v int) {
+		if v == m {
+			child := addVertex(a, out, in)
+			if childCert, ok := canonicalAugmentation(child, nil, cert, seen); ok {
+				s.extend(child, childCert)
+			}
+			return
+		}
+		for _, option := range s.options {
+			out[v], in[v] = option[0], option[1]
+			choose(v + 1)
+		}
+	}
+	choose(0)
+}
+
+// Generates the digraphs of order n whose vertices are joined as allowed by
+// the options.
+func generateDigraphs(n int, options [][2]byte, connected bool, f func(*StaticDigraph)) {
+	if n < 0 {
+		return
+	}
+	s := &digraphSearch{
+		n:         n,
+		options:   options,
+		connected: connected,
+		f:         f,
+	}
+	empty := emptyMatrix(0)
+	s.extend(empty, isomorphism.Certificate(graph.NewFromMatrix(empty)))
+}
+
+// Digraphs calls f with every digraph of order n without loops, up to
+// isomorphism. If connected is true, only weakly connected digraphs are
+// generated. The digraphs are modelled by adjacency matrices.
+func Digraphs(n int, connected bool, f func(*StaticDigraph)) {
+	generateDigraphs(n, [][2]byte{{0, 0}, {1, 0}, {0, 1}, {1, 1}}, connected, f)
+}
+
+// OrientedGraphs calls f with every oriented graph of order n, that is, every
+// digraph without loops nor pairs of opposite arcs, up to isomorphism. If
+// connected is true, only weakly connected oriented graphs are generated. The
+// oriented graphs are modelled by adjacency matrices.
+func OrientedGraphs(n int, connected bool, f func(*StaticDigraph)) {
+	generateDigraphs(n, [][2]byte{{0, 0}, {1, 0}, {0, 1}}, connected, f)
+}
+
+// Tournaments calls f with every tournament of order n, up to isomorphism, in
+// the manner of gentourng. The tournaments are modelled by adjacency matrices.
+func Tournaments(n int, f func(*StaticDigraph)) {
+	generateDigraphs(n, [][2]byte{{1, 0}, {0, 1}}, false, f)
+}
+
+// Orientations calls f with every orientation of a graph, up to isomorphism,
+// in the manner of directg -o. The 2^m orientations of a graph of size m are
+// considered, and those isomorphic to an already generated one are discarded,
+// so this is intended for graphs with few edges. The orientations are modelled
+// by adjacency matrices. Loops and multiple edges are ignored.
+func Orientations(g *StaticGraph, f func(*StaticDigraph)) {
+	a := graph.MatrixOf(g)
+	var edges [][2]int
+	for i := range a {
+		for j := i + 1; j < len(a); j++ {
+			if a[i][j] != 0 {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	for mask := 0; mask < 1<<len(edges); mask++ {
+		b := emptyMatrix(len(a))
+		for k, e := range edges {
+			if mask&(1<<k) == 0 {
+				b[e[0]][e[1]] = 1
+			} else {
+				b[e[1]][e[0]] = 1
+			}
+		}
+		d := graph.NewDigraphFromMatrix(b)
+		if cert := isomorphism.Certificate(d); !seen[cert] {
+			seen[cert] = true
+			f(d)
+		}
+	}
+}
+
+// The parameters of the generation of bipartite graphs, where the bounds of
+// the degrees are given for each part.
+type bipartiteSearch struct {
+	n1, n2               int
+	minDegree, maxDegree [2]int
+	connected            bool
+	f                    func(*graph.BipartiteGraph)
+}
+
+// Generates the descendants of a bipartite graph with a given degree of every
+// vertex and a given certificate. The vertices of the first part are always
+// present, and the vertices of the second part are added one at a time, each
+// with its neighbourhood in the first part.
+func (s *bipartiteSearch) extend(a graph.AdjacencyMatrix, degrees []int, cert string) {
+	m := len(a)
+	if m == s.n1+s.n2 {
+		if !s.connected || isConnected(a) {
+			s.f(graph.NewBipartite(graph.NewFromMatrix(a), bipartiteColours(s.n1, s.n2)))
+		}
+		return
+	}
+	colours := bipartiteColours(s.n1, m+1-s.n1)
+	remaining := s.n1 + s.n2 - m - 1
+	seen := make(map[string]bool)
+	row := make([]byte, m+1)
+	var choose func(v, d int)
+	choose = func(v, d int) {
+		if v == s.n1 {
+			if d < s.minDegree[1] {
+				return
+			}
+			child := addVertex(a, row, row)
+			childCert, ok := canonicalAugmentation(child, colours, cert, seen)
+			if !ok {
+				return
+			}
+			childDegrees := make([]int, m+1)
+			for w := range a {
+				childDegrees[w] = degrees[w] + int(row[w])
+			}
+			childDegrees[m] = d
+			s.extend(child, childDegrees, childCert)
+			return
+		}
+		if degrees[v]+remaining >= s.minDegree[0] {
+			row[v] = 0
+			choose(v+1, d)
+		}
+		if d < s.maxDegree[1] && degrees[v] < s.maxDegree[0] &&
+			degrees[v]+1+remaining >= s.minDegree[0] {
+			row[v] = 1
+			choose(v+1, d+1)
+			row[v] = 0
+		}
+	}
+	choose(0, 0)
+}
+
+// Returns the colours of the vertices of a bipartite graph whose first n1
+// vertices form the first part, and whose last n2 vertices form the second.
+func bipartiteColours(n1, n2 int) []int {
+	colours := make([]int, n1+n2)
+	for v := n1; v < n1+n2; v++ {
+		colours[v] = 1
+	}
+	return colours
+}
+
+// BoundedDegreeBipartiteGraphs calls f with every bipartite graph whose first
+// part has n1 vertices with degrees between minDegree1 and maxDegree1, and
+// whose second part has n2 vertices with degrees between minDegree2 and
+// maxDegree2, up to isomorphisms preserving the parts, in the manner of genbg.
+// The vertices of the first part are 0, ..., n1-1. If connected is true, only
+// connected graphs are generated. The graphs are modelled by adjacency
+// matrices. If the parameters are negative or a minimum degree is greater than
+// the corresponding maximum degree, an error is returned.
+func BoundedDegreeBipartiteGraphs(n1, n2, minDegree1, maxDegree1, minDegree2, maxDegree2 int,
+	connected bool, f func(*graph.BipartiteGraph)) error {
+	if n1 < 0 || n2 < 0 || minDegree1 < 0 || minDegree2 < 0 ||
+		minDegree1 > maxDegree1 || minDegree2 > maxDegree2 {
+		return InvalidParameters
+	}
+	s := &bipartiteSearch{
+		n1:        n1,
+		n2:        n2,
+		minDegree: [2]int{minDegree1, minDegree2},
+		maxDegree: [2]int{maxDegree1, maxDegree2},
+		connected: connected,
+		f:         f,
+	}
+	if n2 == 0 {
+		if (n1 == 0 || minDegree1 == 0) && (!connected || n1 <= 1) {
+			f(graph.NewBipartite(graph.NewFromMatrix(emptyMatrix(n1)), bipartiteColours(n1, 0)))
+		}
+		return nil
+	}
+	empty := emptyMatrix(n1)
+	cert, _ := isomorphism.ColouredCertificate(graph.NewFromMatrix(empty), bipartiteColours(n1, 0))
+	s.extend(empty, make([]int, n1), cert)
+	return nil
+}
+
+// BipartiteGraphs calls f with every bipartite graph with parts of n1 and n2
+// vertices, up to isomorphisms preserving the parts, in the manner of genbg.
+// The vertices of the first part are 0, ..., n1-1. If connected is true, only
+// connected graphs are generated. The graphs are modelled by adjacency
+// matrices.
+func BipartiteGraphs(n1, n2 int, connected bool, f func(*graph.BipartiteGraph)) {
+	if n1 >= 0 && n2 >= 0 {
+		BoundedDegreeBipartiteGraphs(n1, n2, 0, n2, 0, n1, connected, f)
+	}
+}
@@ -0,0 +1,291 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// Checks that the graphs produced by a generator are pairwise non-isomorphic
+// and satisfy a property, and returns how many there are.
+func countDistinct(t *testing.T, generate func(func(*StaticGraph)), property func(*StaticGraph) bool) int {
+	certificates := make(map[string]bool)
+	count := 0
+	generate(func(g *StaticGraph) {
+		cert := isomorphism.Certificate(g)
+		if certificates[cert] {
+			t.Errorf("Graph %v was generated twice", g)
+		}
+		if !property(g) {
+			t.Errorf("Graph %v does not have the expected property", g)
+		}
+		certificates[cert] = true
+		count++
+	})
+	return count
+}
+
+// Returns whether the degrees of a graph are between two bounds.
+func degreesBetween(g Graph, low, high int) bool {
+	for _, d := range g.DegreeSequence() {
+		if d < low || d > high {
+			return false
+		}
+	}
+	return true
+}
+
+// TestGraphs checks the number of graphs (OEIS A000088) and connected graphs
+// (OEIS A001349) of small orders.
+func TestGraphs(t *testing.T) {
+	all := []int{1, 1, 2, 4, 11, 34, 156, 1044}
+	connected := []int{1, 1, 1, 2, 6, 21, 112, 853}
+	for n := range all {
+		count := countDistinct(t, func(f func(*StaticGraph)) { Graphs(n, false, f) },
+			func(g *StaticGraph) bool { return g.Order() == n })
+		if count != all[n] {
+			t.Errorf("Expected %v graphs of order %v, got %v", all[n], n, count)
+		}
+		count = countDistinct(t, func(f func(*StaticGraph)) { Graphs(n, true, f) },
+			func(g *StaticGraph) bool { a, _ := g.Matrix(); return isConnected(a) })
+		if count != connected[n] {
+			t.Errorf("Expected %v connected graphs of order %v, got %v", connected[n], n, count)
+		}
+	}
+}
+
+// TestRegularGraphs checks the number of cubic graphs (OEIS A005638),
+// connected cubic graphs (OEIS A002851) and connected quartic graphs (OEIS
+// A006820).
+func TestRegularGraphs(t *testing.T) {
+	cases := []struct {
+		n, k      int
+		connected bool
+		expected  int
+	}{
+		{4, 3, false, 1}, {6, 3, false, 2}, {8, 3, false, 6}, {10, 3, false, 21},
+		{4, 3, true, 1}, {6, 3, true, 2}, {8, 3, true, 5}, {10, 3, true, 19},
+		{5, 4, true, 1}, {6, 4, true, 1}, {7, 4, true, 2}, {8, 4, true, 6},
+		{9, 4, true, 16}, {7, 3, false, 0}, {6, 0, false, 1},
+	}
+	for _, c := range cases {
+		count := countDistinct(t, func(f func(*StaticGraph)) { RegularGraphs(c.n, c.k, c.connected, f) },
+			func(g *StaticGraph) bool { return isRegular(g, c.k) })
+		if count != c.expected {
+			t.Errorf("Expected %v %v-regular graphs of order %v (connected: %v), got %v",
+				c.expected, c.k, c.n, c.connected, count)
+		}
+	}
+}
+
+// TestBoundedDegreeGraphs compares the graphs with bounded degrees against
+// the filtered list of all graphs.
+func TestBoundedDegreeGraphs(t *testing.T) {
+	for _, bounds := range [][2]int{{0, 2}, {1, 3}, {2, 4}, {3, 5}} {
+		expected := 0
+		Graphs(7, false, func(g *StaticGraph) {
+			if degreesBetween(g, bounds[0], bounds[1]) {
+				expected++
+			}
+		})
+		count := countDistinct(t, func(f func(*StaticGraph)) { BoundedDegreeGraphs(7, bounds[0], bounds[1], false, f) },
+			func(g *StaticGraph) bool { return degreesBetween(g, bounds[0], bounds[1]) })
+		if count != expected {
+			t.Errorf("Expected %v graphs with degrees in %v, got %v", expected, bounds, count)
+		}
+	}
+	if err := BoundedDegreeGraphs(5, 3, 2, false, func(*StaticGraph) {}); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+}
+
+// Checks that the digraphs produced by a generator are pairwise
+// non-isomorphic and satisfy a property, and returns how many there are.
+func countDistinctDigraphs(t *testing.T, generate func(func(*StaticDigraph)), property func(graph.AdjacencyMatrix) bool) int {
+	certificates := make(map[string]bool)
+	count := 0
+	generate(func(d *StaticDigraph) {
+		cert := isomorphism.Certificate(d)
+		a, _ := d.Matrix()
+		if certificates[cert] {
+			t.Errorf("Digraph %v was generated twice", a)
+		}
+		if !property(a) {
+			t.Errorf("Digraph %v does not have the expected property", a)
+		}
+		certificates[cert] = true
+		count++
+	})
+	return count
+}
+
+// Returns whether there are no loops in a matrix, and the number of arcs
+// between two different vertices is within the given bounds.
+func arcsBetween(a graph.AdjacencyMatrix, low, high int) bool {
+	for i := range a {
+		if a[i][i] != 0 {
+			return false
+		}
+		for j := i + 1; j < len(a); j++ {
+			if arcs := int(a[i][j] + a[j][i]); arcs < low || arcs > high {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// TestDigraphs checks the number of digraphs (OEIS A000273), weakly connected
+// digraphs (OEIS A003085), oriented graphs (OEIS A001174) and tournaments
+// (OEIS A000568).
+func TestDigraphs(t *testing.T) {
+	digraphs := []int{1, 1, 3, 16, 218}
+	connected := []int{1, 1, 2, 13, 199}
+	for n := range digraphs {
+		count := countDistinctDigraphs(t, func(f func(*StaticDigraph)) { Digraphs(n, false, f) },
+			func(a graph.AdjacencyMatrix) bool { return arcsBetween(a, 0, 2) })
+		if count != digraphs[n] {
+			t.Errorf("Expected %v digraphs of order %v, got %v", digraphs[n], n, count)
+		}
+		count = countDistinctDigraphs(t, func(f func(*StaticDigraph)) { Digraphs(n, true, f) },
+			isConnected)
+		if count != connected[n] {
+			t.Errorf("Expected %v connected digraphs of order %v, got %v", connected[n], n, count)
+		}
+	}
+	oriented := []int{1, 1, 2, 7, 42, 582}
+	for n := range oriented {
+		count := countDistinctDigraphs(t, func(f func(*StaticDigraph)) { OrientedGraphs(n, false, f) },
+			func(a graph.AdjacencyMatrix) bool { return arcsBetween(a, 0, 1) })
+		if count != oriented[n] {
+			t.Errorf("Expected %v oriented graphs of order %v, got %v", oriented[n], n, count)
+		}
+	}
+	tournaments := []int{1, 1, 1, 2, 4, 12, 56, 456}
+	for n := range tournaments {
+		count := countDistinctDigraphs(t, func(f func(*StaticDigraph)) { Tournaments(n, f) },
+			func(a graph.AdjacencyMatrix) bool { return arcsBetween(a, 1, 1) })
+		if count != tournaments[n] {
+			t.Errorf("Expected %v tournaments of order %v, got %v", tournaments[n], n, count)
+		}
+	}
+}
+
+// TestOrientations checks that the orientations of all the graphs of order 5
+// are the oriented graphs of order 5, and that the orientations of a complete
+// graph are the tournaments.
+func TestOrientations(t *testing.T) {
+	total := 0
+	Graphs(5, false, func(g *StaticGraph) {
+		a, _ := g.Matrix()
+		total += countDistinctDigraphs(t, func(f func(*StaticDigraph)) { Orientations(g, f) },
+			func(b graph.AdjacencyMatrix) bool {
+				for i := range a {
+					for j := range a {
+						if a[i][j] != b[i][j]+b[j][i] {
+							return false
+						}
+					}
+				}
+				return true
+			})
+	})
+	if total != 582 {
+		t.Errorf("Expected 582 orientations of graphs of order 5, got %v", total)
+	}
+	count := countDistinctDigraphs(t, func(f func(*StaticDigraph)) { Orientations(CompleteMatrixGraph(6), f) },
+		func(a graph.AdjacencyMatrix) bool { return arcsBetween(a, 1, 1) })
+	if count != 56 {
+		t.Errorf("Expected 56 orientations of K6, got %v", count)
+	}
+}
+
+// Returns the number of bipartite graphs with parts of n1 and n2 vertices, up
+// to isomorphisms preserving the parts, satisfying a property, by considering
+// all their biadjacency matrices.
+func bruteForceBipartite(n1, n2 int, property func(graph.AdjacencyMatrix) bool) int {
+	colours := bipartiteColours(n1, n2)
+	certificates := make(map[string]bool)
+	for mask := 0; mask < 1<<(n1*n2); mask++ {
+		a := emptyMatrix(n1 + n2)
+		for i := 0; i < n1; i++ {
+			for j := 0; j < n2; j++ {
+				if mask&(1<<(i*n2+j)) != 0 {
+					addEdge(a, i, n1+j)
+				}
+			}
+		}
+		if property(a) {
+			cert, _ := isomorphism.ColouredCertificate(graph.NewFromMatrix(a), colours)
+			certificates[cert] = true
+		}
+	}
+	return len(certificates)
+}
+
+// TestBipartiteGraphs checks the number of bipartite graphs with parts of
+// given sizes (OEIS A028657), and the connected and bounded degree ones
+// against a brute force search.
+func TestBipartiteGraphs(t *testing.T) {
+	expected := map[[2]int]int{
+		{0, 0}: 1, {0, 3}: 1, {3, 0}: 1, {1, 1}: 2, {1, 4}: 5, {4, 1}: 5,
+		{2, 2}: 7, {2, 3}: 13, {3, 2}: 13, {3, 3}: 36, {2, 4}: 22, {3, 4}: 87,
+		{4, 4}: 317,
+	}
+	for sides, count := range expected {
+		n1, n2 := sides[0], sides[1]
+		certificates := make(map[string]bool)
+		got := 0
+		BipartiteGraphs(n1, n2, false, func(b *graph.BipartiteGraph) {
+			first, second := b.Parts()
+			if len(first) != n1 || len(second) != n2 {
+				t.Errorf("Expected parts of %v and %v vertices, got %v and %v", n1, n2, first, second)
+			}
+			cert, _ := isomorphism.ColouredCertificate(b, b.Colours())
+			if certificates[cert] {
+				t.Errorf("Bipartite graph %v was generated twice", b)
+			}
+			certificates[cert] = true
+			got++
+		})
+		if got != count {
+			t.Errorf("Expected %v bipartite graphs with parts %v, got %v", count, sides, got)
+		}
+	}
+	for _, sides := range [][2]int{{2, 3}, {3, 3}, {3, 4}, {4, 3}} {
+		n1, n2 := sides[0], sides[1]
+		got := 0
+		BipartiteGraphs(n1, n2, true, func(*graph.BipartiteGraph) { got++ })
+		if want := bruteForceBipartite(n1, n2, isConnected); got != want {
+			t.Errorf("Expected %v connected bipartite graphs with parts %v, got %v", want, sides, got)
+		}
+		got = 0
+		BoundedDegreeBipartiteGraphs(n1, n2, 1, 2, 2, 3, false, func(b *graph.BipartiteGraph) {
+			a, _ := b.Matrix()
+			for v, row := range a {
+				d := rowDegree(row)
+				if (v < n1 && (d < 1 || d > 2)) || (v >= n1 && d < 2) {
+					t.Errorf("Bipartite graph %v does not have the expected degrees", a)
+				}
+			}
+			got++
+		})
+		want := bruteForceBipartite(n1, n2, func(a graph.AdjacencyMatrix) bool {
+			for v, row := range a {
+				d := rowDegree(row)
+				if (v < n1 && (d < 1 || d > 2)) || (v >= n1 && (d < 2 || d > 3)) {
+					return false
+				}
+			}
+			return true
+		})
+		if got != want {
+			t.Errorf("Expected %v bipartite graphs with bounded degrees and parts %v, got %v",
+				want, sides, got)
+		}
+	}
+	if err := BoundedDegreeBipartiteGraphs(2, 2, 2, 1, 0, 2, false, func(*graph.BipartiteGraph) {}); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+}
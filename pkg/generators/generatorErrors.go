@@ -0,0 +1,14 @@
+package generators
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+var (
+	InvalidParameters = graph.GraphError("Invalid parameters for the family of graphs")
+	InvalidPaleyOrder = graph.GraphError("Order of a Paley graph must be a prime power congruent to 1 modulo 4")
+	InvalidGroupTable = graph.GraphError("Table is not the multiplication table of a group with identity 0")
+	InvalidElement    = graph.GraphError("Element does not belong to the group")
+	NotGraphic        = graph.GraphError("Sequence is not the degree sequence of a graph")
+	NotDigraphic      = graph.GraphError("Sequences are not the degree sequences of a digraph")
+)
@@ -1,6 +1,12 @@
+// Package generators provides named graphs and families of graphs, random
+// graphs, trees, exhaustive generation up to isomorphism, realisations of
+// degree sequences, and verifiers of properties of sets of vertices. Every
+// random generator receives its source of randomness, so the same seed always
+// produces the same graph.
 package generators
 
 import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/set"
 	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
 	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
 )
@@ -62,18 +68,30 @@ func CompleteMatrixDigraph(n int) *StaticDigraph {
 	return graph.NewDigraphFromMatrix(completeMatrix(n))
 }
 
+// Makes a complete adjacency list of order n.
+func completeList(n int) graph.AdjacencyList {
+	l := make([][]int, n, n)
+	for i := range l {
+		l[i] = make([]int, 0, n-1)
+		for j := 0; j < n; j++ {
+			if i != j {
+				l[i] = append(l[i], j)
+			}
+		}
+	}
+	return l
+}
+
 // CompleteListGraph returns a complete graph of order n modelled by an
 // adjacency list.
 func CompleteListGraph(n int) *StaticGraph {
-	// TODO: implement
-	return nil
+	return graph.NewFromList(completeList(n))
 }
 
 // CompleteListDigraph returns a complete digraph of order n modelled by an
 // adjacency list.
 func CompleteListDigraph(n int) *StaticDigraph {
-	// TODO: implement
-	return nil
+	return graph.NewDigraphFromList(completeList(n))
 }
 
 // IsCompleteBipartite checks whether a graph/digraph is a complete bipartite
@@ -111,14 +129,52 @@ func IsCompleteBipartite(g Graph) bool {
 			}
 		}
 		return true
-	} else if _, err := g.List(); err != graph.NilAdjacencyList {
-		// TODO: deal with adjacency list case
-		return false
+	} else if a, err := g.List(); err != graph.NilAdjacencyList {
+		if len(a) == 0 {
+			return true
+		}
+		y := set.NewIntSet()
+		for _, v := range a[0] {
+			if y.Add(v) {
+				return false
+			}
+		}
+		x := set.NewIntSet()
+		for v := range a {
+			if !y.Contains(v) {
+				x.Add(v)
+			}
+		}
+		for v := range a {
+			if y.Contains(v) {
+				if !isListOf(a[v], x) {
+					return false
+				}
+			} else if !isListOf(a[v], y) {
+				return false
+			}
+		}
+		return true
 	} else {
 		return false
 	}
 }
 
+// Checks whether the items of a list are exactly those of a set, without
+// repetitions.
+func isListOf(l []int, s *set.IntSet) bool {
+	if len(l) != len(s.Items()) {
+		return false
+	}
+	seen := set.NewIntSet()
+	for _, v := range l {
+		if !s.Contains(v) || seen.Add(v) {
+			return false
+		}
+	}
+	return true
+}
+
 // Makes a complete bipartite adjacency matrix of order n+m.
 func completeBipartiteMatrix(n, m int) graph.AdjacencyMatrix {
 	a := make([][]byte, n+m, n+m)
@@ -145,30 +201,44 @@ func CompleteBipartiteMatrixDigraph(n, m int) *StaticDigraph {
 	return graph.NewDigraphFromMatrix(completeBipartiteMatrix(n, m))
 }
 
+// Makes a complete bipartite adjacency list of order n+m.
+func completeBipartiteList(n, m int) graph.AdjacencyList {
+	l := make([][]int, n+m, n+m)
+	for i := range l {
+		if i < n {
+			l[i] = make([]int, 0, m)
+			for j := n; j < n+m; j++ {
+				l[i] = append(l[i], j)
+			}
+		} else {
+			l[i] = make([]int, 0, n)
+			for j := 0; j < n; j++ {
+				l[i] = append(l[i], j)
+			}
+		}
+	}
+	return l
+}
+
 // CompleteBipartiteListGraph returns a complete bipartite graph modelled by an
 // adjacency list, with parts of cardinality n and m.
 func CompleteBipartiteListGraph(n, m int) *StaticGraph {
-	// TODO: implement
-	return nil
+	return graph.NewFromList(completeBipartiteList(n, m))
 }
 
 // CompleteBipartiteListDigraph returns a complete bipartite digraph modelled by an
 // adjacency list, with parts of cardinality n and m.
 func CompleteBipartiteListDigraph(n, m int) *StaticDigraph {
-	// TODO: implement
-	return nil
+	return graph.NewDigraphFromList(completeBipartiteList(n, m))
 }
 
 // IsCycle checks whether a graph is an irreflexive cycle or not.
 func IsCycle(g *StaticGraph) bool {
-	d := g.DegreeSequence()
-	for _, v := range d {
-		if v != 2 {
-			return false
-		}
+	if g.Order() == 0 || g.MinDegree() != 2 || g.MaxDegree() != 2 {
+		return false
 	}
 	if a, err := g.Matrix(); err != graph.NilAdjacencyMatrix {
-		n := len(d)
+		n := g.Order()
 		i := 0
 		j := sliceutils.NextNonZero(a[i], 0)
 		k := j
@@ -182,19 +252,114 @@ func IsCycle(g *StaticGraph) bool {
 			m++
 		}
 		return m+1 == n
-	} else if _, err := g.List(); err != graph.NilAdjacencyMatrix {
-		// TODO: deal with adjacency list case
-		return false
+	} else if a, err := g.List(); err != graph.NilAdjacencyList {
+		n := len(a)
+		for i, l := range a {
+			if l[0] == i || l[1] == i || l[0] == l[1] {
+				return false
+			}
+		}
+		i := 0
+		j := a[i][0]
+		m := 1
+		for j != 0 && m < n {
+			k := a[j][0]
+			if k == i {
+				k = a[j][1]
+			}
+			i = j
+			j = k
+			m++
+		}
+		return j == 0 && m == n
 	} else {
 		return false
 	}
 }
 
+// Returns the only out-neighbour of a vertex of out-degree one in a digraph,
+// or -1 if the arc to it is not simple.
+func successor(d *StaticDigraph, v int) int {
+	if a, err := d.Matrix(); err == nil {
+		for j, w := range a[v] {
+			if w == 1 {
+				return j
+			} else if w != 0 {
+				return -1
+			}
+		}
+		return -1
+	}
+	a, _ := d.List()
+	return a[v][0]
+}
+
+// Follows the arcs of a digraph, where every vertex has out-degree at most
+// one, starting from a given vertex for at most n steps or until a vertex
+// without out-neighbours is reached. Returns the last vertex reached and the
+// number of steps.
+func followArcs(d *StaticDigraph, start int) (int, int) {
+	n := d.Order()
+	out := d.Outdegrees()
+	v := start
+	m := 0
+	for m < n && out[v] == 1 {
+		w := successor(d, v)
+		if w == -1 || w == v {
+			return v, m
+		}
+		v = w
+		m++
+		if v == start {
+			break
+		}
+	}
+	return v, m
+}
+
 // IsDirectedCycle checks whether a digraph is an irreflexive directed cycle
 // or not.
-func IsDirectedCycle(d StaticDigraph) bool {
-	// TODO: implement
-	return false
+func IsDirectedCycle(d *StaticDigraph) bool {
+	n := d.Order()
+	if n == 0 {
+		return false
+	}
+	in := d.Indegrees()
+	out := d.Outdegrees()
+	for i := 0; i < n; i++ {
+		if in[i] != 1 || out[i] != 1 {
+			return false
+		}
+	}
+	v, m := followArcs(d, 0)
+	return v == 0 && m == n
+}
+
+// MatrixDirectedCycle returns an irreflexive directed cycle of order n in
+// canonical order, modelled by an adjacency matrix.
+func MatrixDirectedCycle(n int) *StaticDigraph {
+	a := make([][]byte, n, n)
+	for i := range a {
+		a[i] = make([]byte, n, n)
+		if n > 1 {
+			a[i][(i+1)%n] = 1
+		}
+	}
+	return graph.NewDigraphFromMatrix(a)
+}
+
+// ListDirectedCycle returns an irreflexive directed cycle of order n in
+// canonical order, modelled by an adjacency list.
+func ListDirectedCycle(n int) *StaticDigraph {
+	l := make([][]int, n, n)
+	for i := range l {
+		if n > 1 {
+			l[i] = []int{(i + 1) % n}
+		} else {
+			l[i] = []int{}
+		}
+	}
+	return graph.NewDigraphFromList(l)
 }
 
 // MatrixCycle returns an irreflexive cycle of order n in canonical order.
@@ -207,9 +372,20 @@ func MatrixCycle(n int) *graph.StaticGraph {
 	return graph.NewFromMatrix(c)
 }
 
+// ListCycle returns an irreflexive cycle of order n in canonical order,
+// modelled by an adjacency list.
+func ListCycle(n int) *StaticGraph {
+	l := listPath(n)
+	if n > 2 {
+		l[0] = append(l[0], n-1)
+		l[n-1] = append(l[n-1], 0)
+	}
+	return graph.NewFromList(l)
+}
+
 // IsPath checks whether a graph is an irreflexive path or not.
 func IsPath(g *StaticGraph) bool {
-	d := g.DegreeSequence()
+	d := g.Degrees()
 	if len(d) == 1 && d[0] == 0 {
 		return true
 	}
@@ -249,9 +425,28 @@ func IsPath(g *StaticGraph) bool {
 			m++
 		}
 		return m+1 == n
-	} else if _, err := g.List(); err != graph.NilAdjacencyMatrix {
-		// TODO: deal with adjacency list case
-		return false
+	} else if a, err := g.List(); err != graph.NilAdjacencyList {
+		n := len(a)
+		for i, l := range a {
+			for j, v := range l {
+				if v == i || (j > 0 && v == l[0]) {
+					return false
+				}
+			}
+		}
+		i := start
+		j := a[i][0]
+		m := 1
+		for j != end && m < n {
+			k := a[j][0]
+			if k == i {
+				k = a[j][1]
+			}
+			i = j
+			j = k
+			m++
+		}
+		return j == end && m+1 == n
 	} else {
 		return false
 	}
@@ -259,8 +454,31 @@ func IsPath(g *StaticGraph) bool {
 
 // IsDirectedPath checks whether a graph is an irreflexive directed path or not.
 func IsDirectedPath(g *StaticDigraph) bool {
-	// TODO: implement
-	return false
+	n := g.Order()
+	if n == 0 {
+		return false
+	}
+	in := g.Indegrees()
+	out := g.Outdegrees()
+	if n == 1 {
+		return in[0] == 0 && out[0] == 0
+	}
+	start := -1
+	for i := 0; i < n; i++ {
+		if in[i] == 0 && out[i] == 1 {
+			if start != -1 {
+				return false
+			}
+			start = i
+		} else if !(in[i] == 1 && out[i] == 1) && !(in[i] == 1 && out[i] == 0) {
+			return false
+		}
+	}
+	if start == -1 {
+		return false
+	}
+	v, m := followArcs(g, start)
+	return out[v] == 0 && m+1 == n
 }
 
 func matrixPath(n int) graph.AdjacencyMatrix {
@@ -284,6 +502,26 @@ func MatrixPath(n int) *StaticGraph {
 	return graph.NewFromMatrix(matrixPath(n))
 }
 
+func listPath(n int) graph.AdjacencyList {
+	l := make([][]int, n, n)
+	for i := range l {
+		l[i] = make([]int, 0, 2)
+		if i > 0 {
+			l[i] = append(l[i], i-1)
+		}
+		if i < n-1 {
+			l[i] = append(l[i], i+1)
+		}
+	}
+	return l
+}
+
+// ListPath returns an irreflexive path of order n in canonical order, modelled
+// by an adjacency list.
+func ListPath(n int) *StaticGraph {
+	return graph.NewFromList(listPath(n))
+}
+
 // DirectedPath returns an irreflexive dircted path of order n in canonical order.
 func MatrixDirectedPath(n int) *StaticDigraph {
 	a := make([][]byte, n, n)
@@ -299,24 +537,140 @@ func MatrixDirectedPath(n int) *StaticDigraph {
 	return graph.NewDigraphFromMatrix(a)
 }
 
-/*
-// CirculantMatrixDigraph returns a circulant digraph of order n
-// with set of integer jumps s.
-func CirculantMatrixDigraph(n int, jumps map[int]bool) *graph.MatrixDigraph {
+// ListDirectedPath returns an irreflexive directed path of order n in
+// canonical order, modelled by an adjacency list.
+func ListDirectedPath(n int) *StaticDigraph {
+	l := make([][]int, n, n)
+	for i := range l {
+		if i < n-1 {
+			l[i] = []int{i + 1}
+		} else {
+			l[i] = []int{}
+		}
+	}
+	return graph.NewDigraphFromList(l)
+}
+
+// Makes the adjacency matrix of a circulant digraph of order n with a given
+// set of jumps. If symmetric is true, the opposite of every jump is also
+// considered, obtaining an undirected circulant graph.
+func circulantMatrix(n int, jumps []int, symmetric bool) graph.AdjacencyMatrix {
 	a := make([][]byte, n, n)
-	var k int
 	for i := range a {
 		a[i] = make([]byte, n)
-		for j := range jumps {
-			if 0 < j && j < n {
-				k = (i + j) % n
-				a[i][k] = 1
-			} else if j < 0 && -n < j {
-				k = (i + n + j) % n
-				a[i][k] = 1
+	}
+	if n == 0 {
+		return a
+	}
+	for _, j := range jumps {
+		j = ((j % n) + n) % n
+		if j == 0 {
+			continue
+		}
+		for i := range a {
+			a[i][(i+j)%n] = 1
+			if symmetric {
+				a[(i+j)%n][i] = 1
 			}
 		}
 	}
-	return graph.NewMatrixDigraph(a)
+	return a
+}
+
+// CirculantMatrixDigraph returns a circulant digraph of order n with set of
+// integer jumps, modelled by an adjacency matrix. There is an arc from i to
+// i+j (mod n) for every jump j; jumps that are multiples of n are ignored.
+func CirculantMatrixDigraph(n int, jumps []int) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(circulantMatrix(n, jumps, false))
+}
+
+// CirculantListDigraph returns a circulant digraph of order n with set of
+// integer jumps, modelled by an adjacency list. There is an arc from i to
+// i+j (mod n) for every jump j; jumps that are multiples of n are ignored.
+func CirculantListDigraph(n int, jumps []int) *StaticDigraph {
+	return graph.NewDigraphFromList(matrixToList(circulantMatrix(n, jumps, false)))
+}
+
+// CirculantMatrixGraph returns a circulant graph of order n with set of integer
+// jumps, modelled by an adjacency matrix. Vertex i is adjacent to i+j and i-j
+// (mod n) for every jump j; jumps that are multiples of n are ignored.
+func CirculantMatrixGraph(n int, jumps []int) *StaticGraph {
+	return graph.NewFromMatrix(circulantMatrix(n, jumps, true))
+}
+
+// CirculantListGraph returns a circulant graph of order n with set of integer
+// jumps, modelled by an adjacency list. Vertex i is adjacent to i+j and i-j
+// (mod n) for every jump j; jumps that are multiples of n are ignored.
+func CirculantListGraph(n int, jumps []int) *StaticGraph {
+	return graph.NewFromList(matrixToList(circulantMatrix(n, jumps, true)))
+}
+
+// IsCirculant checks whether a graph/digraph is circulant, that is, whether its
+// vertices can be arranged as v(0), ..., v(n-1) so that the adjacency from v(i)
+// to v(j) depends only on j-i (mod n). Circulant graphs are vertex
+// transitive, so v(0) is fixed and the rest of the arrangement is searched by
+// backtracking.
+func IsCirculant(g Graph) bool {
+	a := graph.MatrixOf(g)
+	n := len(a)
+	if n == 0 {
+		return true
+	}
+	out := make([]int, n)
+	in := make([]int, n)
+	for i := range a {
+		if a[i][i] != a[0][0] {
+			return false
+		}
+		for j, w := range a[i] {
+			out[i] += int(w)
+			in[j] += int(w)
+		}
+	}
+	for i := range a {
+		if out[i] != out[0] || in[i] != out[0] {
+			return false
+		}
+	}
+	order := make([]int, n)
+	used := make([]bool, n)
+	used[0] = true
+
+	// Places a vertex as v(k), assuming v(0), ..., v(k-1) are placed.
+	var place func(k int) bool
+	place = func(k int) bool {
+		if k == n {
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if a[order[i]][order[j]] != a[0][order[(j-i+n)%n]] {
+						return false
+					}
+				}
+			}
+			return true
+		}
+		for v := 0; v < n; v++ {
+			if used[v] {
+				continue
+			}
+			order[k] = v
+			consistent := true
+			for i := 1; i < k && consistent; i++ {
+				if a[order[i]][v] != a[0][order[k-i]] {
+					consistent = false
+				} else if n-k+i <= k && a[v][order[i]] != a[0][order[n-k+i]] {
+					consistent = false
+				}
+			}
+			if consistent {
+				used[v] = true
+				if place(k + 1) {
+					return true
+				}
+				used[v] = false
+			}
+		}
+		return false
+	}
+	return place(1)
 }
-*/
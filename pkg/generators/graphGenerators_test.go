@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
 	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
 )
 
@@ -493,7 +494,7 @@ func TestPath(t *testing.T) {
 		n := rand.Intn(1000)
 		if n > 2 {
 			c := MatrixPath(n)
-			d := c.DegreeSequence()
+			d := c.Degrees()
 			for i, v := range d {
 				if i == 0 || i == n-1 {
 					if v != 1 {
@@ -536,7 +537,7 @@ func TestDirectedPath(t *testing.T) {
 		n := rand.Intn(1000)
 		if n > 2 {
 			p := MatrixDirectedPath(n)
-			d := p.DegreeSequence()
+			d := p.Degrees()
 			for i, v := range d {
 				if i == 0 || i == n-1 {
 					if v != 1 {
@@ -546,7 +547,7 @@ func TestDirectedPath(t *testing.T) {
 					t.Error("The vertices do not have degree 2")
 				}
 			}
-			o := p.OutdegreeSequence()
+			o := p.Outdegrees()
 			for i, v := range o {
 				if i == n-1 {
 					if v != 0 {
@@ -581,8 +582,6 @@ func TestDirectedPath(t *testing.T) {
 	}
 }
 
-/*
-
 // TestCirculantMatrixDigraph randomly generates five circulant digraphs by
 // constructing their adjacency matrices from a set of randomly generated
 // jumps.   Then, it calls the CirculantMatrixDigraph function with the same set
@@ -595,10 +594,9 @@ func TestCirculantMatrixDigraph(t *testing.T) {
 	n := 0
 	var a [][]byte
 	var s int
-	var k int
 	var l int
-	jumps := make(map[int]bool)
-	var got *graph.MatrixDigraph
+	var jumps []int
+	var got *StaticDigraph
 	for i := 0; i < 5; i++ {
 		for n == 0 {
 			n = rand.Intn(30)
@@ -608,12 +606,12 @@ func TestCirculantMatrixDigraph(t *testing.T) {
 			a[j] = make([]byte, n, n)
 		}
 		s = rand.Intn(n)
+		jumps = nil
 		for j := 0; j < s; j++ {
-			k = rand.Intn(n)
-			jumps[k] = true
+			jumps = append(jumps, rand.Intn(n))
 		}
 		for j := range a {
-			for jump := range jumps {
+			for _, jump := range jumps {
 				l = (j + jump) % n
 				if l != j {
 					a[j][l] = 1
@@ -621,8 +619,13 @@ func TestCirculantMatrixDigraph(t *testing.T) {
 			}
 		}
 		got = CirculantMatrixDigraph(n, jumps)
-		if !sliceutils.EqualByteMatrix(a, got.Matrix()) {
-			t.Errorf("Expected %v, but got %v", a, got.Matrix())
+		m, _ := got.Matrix()
+		if !sliceutils.EqualByteMatrix(a, m) {
+			t.Errorf("Expected %v, but got %v", a, m)
+		}
+		m = graph.MatrixOf(CirculantListDigraph(n, jumps))
+		if !sliceutils.EqualByteMatrix(a, m) {
+			t.Errorf("Expected %v, but got %v", a, m)
 		}
 	}
 	a = [][]byte{
@@ -635,14 +638,251 @@ func TestCirculantMatrixDigraph(t *testing.T) {
 		{0, 0, 0, 0, 0, 1, 0, 1},
 		{1, 0, 0, 0, 0, 0, 1, 0},
 	}
-	jumps = map[int]bool{
-		1:  true,
-		-1: false,
+	got = CirculantMatrixDigraph(8, []int{1, -1})
+	m, _ := got.Matrix()
+	if !sliceutils.EqualByteMatrix(a, m) {
+		t.Errorf("Expected %v, but got %v", a, m)
+	}
+	m, _ = CirculantMatrixGraph(8, []int{1}).Matrix()
+	if !sliceutils.EqualByteMatrix(a, m) {
+		t.Errorf("Expected %v, but got %v", a, m)
+	}
+	m = graph.MatrixOf(CirculantListGraph(8, []int{9, 16}))
+	if !sliceutils.EqualByteMatrix(a, m) {
+		t.Errorf("Expected %v, but got %v", a, m)
+	}
+	if d := CirculantListDigraph(0, []int{1, 2}); d.Order() != 0 {
+		t.Errorf("Expected the empty digraph, but got %v", d)
+	}
+}
+
+// TestIsCirculant checks that randomly relabelled circulant graphs and
+// digraphs are recognised, along with some well known circulant graphs, and
+// that some non-circulant graphs are rejected.
+func TestIsCirculant(t *testing.T) {
+	r := rand.New(rand.NewSource(30))
+	for k := 0; k < 50; k++ {
+		n := 1 + r.Intn(12)
+		var jumps []int
+		for j := r.Intn(4); j > 0; j-- {
+			jumps = append(jumps, r.Intn(n))
+		}
+		p := r.Perm(n)
+		a, _ := CirculantMatrixDigraph(n, jumps).Matrix()
+		if !IsCirculant(graph.NewDigraphFromMatrix(permuteMatrix(a, p))) {
+			t.Errorf("Circulant digraph %v with jumps %v not recognised", a, jumps)
+		}
+		a, _ = CirculantMatrixGraph(n, jumps).Matrix()
+		if !IsCirculant(graph.NewFromList(matrixToList(permuteMatrix(a, p)))) {
+			t.Errorf("Circulant graph %v with jumps %v not recognised", a, jumps)
+		}
+	}
+	paley, _ := PaleyMatrixGraph(13)
+	prism5, _ := PrismMatrixGraph(5)
+	prism4, _ := PrismMatrixGraph(4)
+	mobius, _ := MobiusLadderListGraph(5)
+	circulants := []Graph{
+		MatrixCycle(7), CompleteMatrixGraph(6), paley, prism5, mobius,
+		CompleteBipartiteMatrixGraph(4, 4),
+	}
+	for _, g := range circulants {
+		if !IsCirculant(g) {
+			t.Errorf("Expected %v, but got %v", true, false)
+		}
+	}
+	nonCirculants := []Graph{
+		PetersenMatrixGraph(), MatrixPath(5), prism4,
+		CompleteBipartiteMatrixGraph(2, 3), MatrixDirectedPath(4),
 	}
-	got = CirculantMatrixDigraph(8, jumps)
-	if !sliceutils.EqualByteMatrix(a, got.Matrix()) {
-		t.Errorf("Expected %v, but got %v", a, got.Matrix())
+	for _, g := range nonCirculants {
+		if IsCirculant(g) {
+			t.Errorf("Expected %v, but got %v", false, true)
+		}
 	}
 }
 
-*/
+// Builds a random matrix of order n, where each entry off the diagonal is one
+// with probability p. If symmetric is true, the matrix is symmetric.
+func randomMatrix(r *rand.Rand, n int, p float64, symmetric bool) [][]byte {
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = make([]byte, n)
+	}
+	for i := range a {
+		for j := range a[i] {
+			if i == j || (symmetric && j < i) {
+				continue
+			}
+			if r.Float64() < p {
+				a[i][j] = 1
+				if symmetric {
+					a[j][i] = 1
+				}
+			}
+		}
+	}
+	return a
+}
+
+// Relabels the vertices of a matrix according to a permutation.
+func permuteMatrix(a [][]byte, p []int) [][]byte {
+	b := make([][]byte, len(a))
+	for i := range b {
+		b[i] = make([]byte, len(a))
+	}
+	for i := range a {
+		for j := range a[i] {
+			b[p[i]][p[j]] = a[i][j]
+		}
+	}
+	return b
+}
+
+// TestListGenerators checks that the generators modelled by adjacency lists
+// produce the same graphs as those modelled by adjacency matrices, and that
+// the recognisers accept both of them.
+func TestListGenerators(t *testing.T) {
+	for n := 1; n < 20; n++ {
+		pairs := [][2]Graph{
+			{CompleteMatrixGraph(n), CompleteListGraph(n)},
+			{CompleteMatrixDigraph(n), CompleteListDigraph(n)},
+			{CompleteBipartiteMatrixGraph(n, n/2), CompleteBipartiteListGraph(n, n/2)},
+			{CompleteBipartiteMatrixDigraph(n/3, n), CompleteBipartiteListDigraph(n/3, n)},
+			{MatrixCycle(n), ListCycle(n)},
+			{MatrixPath(n), ListPath(n)},
+			{MatrixDirectedCycle(n), ListDirectedCycle(n)},
+			{MatrixDirectedPath(n), ListDirectedPath(n)},
+		}
+		for _, pair := range pairs {
+			a, _ := pair[0].Matrix()
+			b := graph.MatrixOf(pair[1])
+			if !sliceutils.EqualByteMatrix(a, b) {
+				t.Errorf("Expected %v, got %v", a, b)
+			}
+			if pair[0].Order() != pair[1].Order() {
+				t.Errorf("Expected order %v, got %v", pair[0].Order(),
+					pair[1].Order())
+			}
+		}
+		for _, g := range []Graph{CompleteMatrixGraph(n), CompleteListGraph(n)} {
+			if !IsComplete(g) {
+				t.Errorf("Complete graph of order %v not recognised", n)
+			}
+		}
+		for _, g := range []Graph{
+			CompleteBipartiteMatrixGraph(n, n/2), CompleteBipartiteListGraph(n, n/2),
+		} {
+			if !IsCompleteBipartite(g) {
+				t.Errorf("Complete bipartite graph of order %v not recognised", n)
+			}
+		}
+		if n > 2 {
+			if !IsCycle(MatrixCycle(n)) || !IsCycle(ListCycle(n)) {
+				t.Errorf("Cycle of order %v not recognised", n)
+			}
+		}
+		if n > 1 {
+			if !IsDirectedCycle(MatrixDirectedCycle(n)) ||
+				!IsDirectedCycle(ListDirectedCycle(n)) {
+				t.Errorf("Directed cycle of order %v not recognised", n)
+			}
+		}
+		if !IsPath(MatrixPath(n)) || !IsPath(ListPath(n)) {
+			t.Errorf("Path of order %v not recognised", n)
+		}
+		if !IsDirectedPath(MatrixDirectedPath(n)) ||
+			!IsDirectedPath(ListDirectedPath(n)) {
+			t.Errorf("Directed path of order %v not recognised", n)
+		}
+	}
+}
+
+// TestRecognisersCrossCheck runs every recogniser on random graphs and
+// digraphs, and on randomly relabelled cycles, paths and complete bipartite
+// graphs, modelled both by adjacency matrices and adjacency lists, and checks
+// that both representations give the same answer.
+func TestRecognisersCrossCheck(t *testing.T) {
+	r := rand.New(rand.NewSource(28))
+	for k := 0; k < 500; k++ {
+		n := 1 + r.Intn(8)
+		p := r.Perm(n)
+		var a [][]byte
+		switch k % 5 {
+		case 0:
+			a, _ = MatrixCycle(n).Matrix()
+		case 1:
+			a, _ = MatrixPath(n).Matrix()
+		case 2:
+			a, _ = CompleteBipartiteMatrixGraph(n/2, n-n/2).Matrix()
+		default:
+			a = randomMatrix(r, n, 0.3, true)
+		}
+		a = permuteMatrix(a, p)
+		m := graph.NewFromMatrix(a)
+		l := graph.NewFromList(matrixToList(a))
+		if IsComplete(m) != IsComplete(l) {
+			t.Errorf("IsComplete differs on %v", a)
+		}
+		if IsCompleteBipartite(m) != IsCompleteBipartite(l) {
+			t.Errorf("IsCompleteBipartite differs on %v", a)
+		}
+		if n > 2 && IsCycle(m) != IsCycle(l) {
+			t.Errorf("IsCycle differs on %v", a)
+		}
+		if IsPath(m) != IsPath(l) {
+			t.Errorf("IsPath differs on %v", a)
+		}
+
+		switch k % 3 {
+		case 0:
+			a, _ = MatrixDirectedCycle(n).Matrix()
+		case 1:
+			a, _ = MatrixDirectedPath(n).Matrix()
+		default:
+			a = randomMatrix(r, n, 0.3, false)
+		}
+		a = permuteMatrix(a, p)
+		dm := graph.NewDigraphFromMatrix(a)
+		dl := graph.NewDigraphFromList(matrixToList(a))
+		if IsDirectedCycle(dm) != IsDirectedCycle(dl) {
+			t.Errorf("IsDirectedCycle differs on %v", a)
+		}
+		if IsDirectedPath(dm) != IsDirectedPath(dl) {
+			t.Errorf("IsDirectedPath differs on %v", a)
+		}
+		if k%3 == 0 && n > 1 && !IsDirectedCycle(dm) {
+			t.Errorf("Directed cycle %v not recognised", a)
+		}
+		if k%3 == 1 && !IsDirectedPath(dm) {
+			t.Errorf("Directed path %v not recognised", a)
+		}
+	}
+}
+
+// TestIsDirectedCycleAndPath calls IsDirectedCycle and IsDirectedPath with
+// hardcoded digraphs, including two disjoint directed cycles, a cycle with an
+// arc reversed, and a directed path with a loop.
+func TestIsDirectedCycleAndPath(t *testing.T) {
+	a := [][]int{{1}, {0}, {3}, {2}}
+	b := [][]int{{1}, {2}, {}, {2, 0}}
+	c := [][]int{{1}, {2}, {3}, {}}
+	d := [][]int{{1}, {2}, {2, 3}, {}}
+	e := [][]int{{}}
+	if IsDirectedCycle(graph.NewDigraphFromList(a)) {
+		t.Errorf("Expected %v, but got %v", false, true)
+	}
+	if IsDirectedCycle(graph.NewDigraphFromList(b)) ||
+		IsDirectedPath(graph.NewDigraphFromList(b)) {
+		t.Errorf("Expected %v, but got %v", false, true)
+	}
+	if !IsDirectedPath(graph.NewDigraphFromList(c)) {
+		t.Errorf("Expected %v, but got %v", true, false)
+	}
+	if IsDirectedPath(graph.NewDigraphFromList(d)) {
+		t.Errorf("Expected %v, but got %v", false, true)
+	}
+	if !IsDirectedPath(graph.NewDigraphFromList(e)) ||
+		IsDirectedCycle(graph.NewDigraphFromList(e)) {
+		t.Errorf("Trivial digraph misclassified")
+	}
+}
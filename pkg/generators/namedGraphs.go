@@ -0,0 +1,860 @@
+package generators
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Makes an adjacency matrix of order n without edges.
+func emptyMatrix(n int) graph.AdjacencyMatrix {
+	a := make([][]byte, n, n)
+	for i := range a {
+		a[i] = make([]byte, n)
+	}
+	return a
+}
+
+// Adds the edge uv to an adjacency matrix, unless u and v are the same vertex.
+func addEdge(a graph.AdjacencyMatrix, u, v int) {
+	if u != v {
+		a[u][v] = 1
+		a[v][u] = 1
+	}
+}
+
+// Makes the adjacency list corresponding to an adjacency matrix.
+func matrixToList(a graph.AdjacencyMatrix) graph.AdjacencyList {
+	l := make([][]int, len(a), len(a))
+	for i := range a {
+		l[i] = make([]int, 0)
+		for j, w := range a[i] {
+			for k := byte(0); k < w; k++ {
+				l[i] = append(l[i], j)
+			}
+		}
+	}
+	return l
+}
+
+// Checks whether an adjacency matrix is symmetric, with entries zero or one
+// and no loops.
+func isSimple(a graph.AdjacencyMatrix) bool {
+	for i := range a {
+		if a[i][i] != 0 {
+			return false
+		}
+		for j := range a[i] {
+			if a[i][j] > 1 || a[i][j] != a[j][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns the number of non-zero entries of a row of an adjacency matrix.
+func rowDegree(row []byte) int {
+	d := 0
+	for _, w := range row {
+		if w != 0 {
+			d++
+		}
+	}
+	return d
+}
+
+// Returns the first vertex adjacent to every other vertex, or -1 if there is
+// no such vertex.
+func universalVertex(a graph.AdjacencyMatrix) int {
+	for i := range a {
+		if rowDegree(a[i]) == len(a)-1 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Makes the adjacency matrix of the subgraph induced by every vertex but v.
+func deleteVertex(a graph.AdjacencyMatrix, v int) graph.AdjacencyMatrix {
+	b := make([][]byte, 0, len(a)-1)
+	for i := range a {
+		if i != v {
+			row := make([]byte, 0, len(a)-1)
+			row = append(row, a[i][:v]...)
+			row = append(row, a[i][v+1:]...)
+			b = append(b, row)
+		}
+	}
+	return b
+}
+
+// Makes the adjacency matrix of the wheel with n spokes.
+func wheelMatrix(n int) (graph.AdjacencyMatrix, error) {
+	if n < 3 {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(n + 1)
+	for i := 1; i <= n; i++ {
+		addEdge(a, 0, i)
+		addEdge(a, i, i%n+1)
+	}
+	return a, nil
+}
+
+// WheelMatrixGraph returns the wheel with n spokes modelled by an adjacency
+// matrix, where n >= 3. The wheel has order n+1, vertex 0 is its hub and the
+// remaining vertices form a cycle in canonical order.
+func WheelMatrixGraph(n int) (*StaticGraph, error) {
+	a, err := wheelMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// WheelListGraph returns the wheel with n spokes modelled by an adjacency list,
+// where n >= 3. The wheel has order n+1, vertex 0 is its hub and the remaining
+// vertices form a cycle in canonical order.
+func WheelListGraph(n int) (*StaticGraph, error) {
+	a, err := wheelMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// IsWheel checks whether a graph is a wheel with at least three spokes.
+func IsWheel(g Graph) bool {
+	a := graph.MatrixOf(g)
+	if len(a) < 4 || !isSimple(a) {
+		return false
+	}
+	hub := universalVertex(a)
+	if hub == -1 {
+		return false
+	}
+	return IsCycle(graph.NewFromMatrix(deleteVertex(a, hub)))
+}
+
+// StarMatrixGraph returns the star with n leaves, the complete bipartite graph
+// K(1,n), modelled by an adjacency matrix. Vertex 0 is its centre.
+func StarMatrixGraph(n int) *StaticGraph {
+	return CompleteBipartiteMatrixGraph(1, n)
+}
+
+// StarListGraph returns the star with n leaves, the complete bipartite graph
+// K(1,n), modelled by an adjacency list. Vertex 0 is its centre.
+func StarListGraph(n int) *StaticGraph {
+	return CompleteBipartiteListGraph(1, n)
+}
+
+// IsStar checks whether a graph is a star, a graph with a vertex adjacent to
+// every other vertex and no other edges.
+func IsStar(g Graph) bool {
+	a := graph.MatrixOf(g)
+	if len(a) == 0 || !isSimple(a) {
+		return false
+	}
+	centre := universalVertex(a)
+	if centre == -1 {
+		return false
+	}
+	for i := range a {
+		if i != centre && len(a) > 2 && rowDegree(a[i]) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Makes the adjacency matrix of the hypercube of dimension n.
+func hypercubeMatrix(n int) graph.AdjacencyMatrix {
+	a := emptyMatrix(1 << uint(n))
+	for i := range a {
+		for b := 0; b < n; b++ {
+			addEdge(a, i, i^(1<<uint(b)))
+		}
+	}
+	return a
+}
+
+// HypercubeMatrixGraph returns the hypercube Q(n) modelled by an adjacency
+// matrix. Its vertices are the integers in [0, 2^n), and two of them are
+// adjacent when their binary representations differ in exactly one bit.
+func HypercubeMatrixGraph(n int) *StaticGraph {
+	return graph.NewFromMatrix(hypercubeMatrix(n))
+}
+
+// HypercubeListGraph returns the hypercube Q(n) modelled by an adjacency list.
+// Its vertices are the integers in [0, 2^n), and two of them are adjacent when
+// their binary representations differ in exactly one bit.
+func HypercubeListGraph(n int) *StaticGraph {
+	return graph.NewFromList(matrixToList(hypercubeMatrix(n)))
+}
+
+// Makes the adjacency matrix of the generalized Petersen graph GP(n,k).
+func generalizedPetersenMatrix(n, k int) (graph.AdjacencyMatrix, error) {
+	if n < 3 || k < 1 || 2*k >= n {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(2 * n)
+	for i := 0; i < n; i++ {
+		addEdge(a, i, (i+1)%n)
+		addEdge(a, i, n+i)
+		addEdge(a, n+i, n+(i+k)%n)
+	}
+	return a, nil
+}
+
+// GeneralizedPetersenMatrixGraph returns the generalized Petersen graph GP(n,k)
+// modelled by an adjacency matrix, where n >= 3 and 1 <= k < n/2. Vertices
+// 0, ..., n-1 form the outer cycle, and each vertex i is adjacent to the inner
+// vertex n+i, which in turn is adjacent to n+(i+k mod n).
+func GeneralizedPetersenMatrixGraph(n, k int) (*StaticGraph, error) {
+	a, err := generalizedPetersenMatrix(n, k)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// GeneralizedPetersenListGraph returns the generalized Petersen graph GP(n,k)
+// modelled by an adjacency list, with the same labelling as
+// GeneralizedPetersenMatrixGraph.
+func GeneralizedPetersenListGraph(n, k int) (*StaticGraph, error) {
+	a, err := generalizedPetersenMatrix(n, k)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// PetersenMatrixGraph returns the Petersen graph, as the generalized Petersen
+// graph GP(5,2), modelled by an adjacency matrix.
+func PetersenMatrixGraph() *StaticGraph {
+	g, _ := GeneralizedPetersenMatrixGraph(5, 2)
+	return g
+}
+
+// PetersenListGraph returns the Petersen graph, as the generalized Petersen
+// graph GP(5,2), modelled by an adjacency list.
+func PetersenListGraph() *StaticGraph {
+	g, _ := GeneralizedPetersenListGraph(5, 2)
+	return g
+}
+
+// Returns the subsets of cardinality k of {0, ..., n-1}, each one sorted in
+// increasing order, in lexicographic order.
+func kSubsets(n, k int) [][]int {
+	var subsets [][]int
+	current := make([]int, 0, k)
+	var extend func(start int)
+	extend = func(start int) {
+		if len(current) == k {
+			subset := make([]int, k)
+			copy(subset, current)
+			subsets = append(subsets, subset)
+			return
+		}
+		for i := start; i <= n-(k-len(current)); i++ {
+			current = append(current, i)
+			extend(i + 1)
+			current = current[:len(current)-1]
+		}
+	}
+	extend(0)
+	return subsets
+}
+
+// Returns the number of common items of two sorted slices.
+func intersectionSize(x, y []int) int {
+	i, j, c := 0, 0, 0
+	for i < len(x) && j < len(y) {
+		if x[i] == y[j] {
+			c++
+			i++
+			j++
+		} else if x[i] < y[j] {
+			i++
+		} else {
+			j++
+		}
+	}
+	return c
+}
+
+// Makes the adjacency matrix of a graph whose vertices are the subsets of
+// cardinality k of {0, ..., n-1}, where two subsets are adjacent when their
+// intersection has cardinality m.
+func subsetsMatrix(n, k, m int) (graph.AdjacencyMatrix, error) {
+	if n < 0 || k < 0 || k > n {
+		return nil, InvalidParameters
+	}
+	subsets := kSubsets(n, k)
+	a := emptyMatrix(len(subsets))
+	for i := range subsets {
+		for j := i + 1; j < len(subsets); j++ {
+			if intersectionSize(subsets[i], subsets[j]) == m {
+				addEdge(a, i, j)
+			}
+		}
+	}
+	return a, nil
+}
+
+// KneserMatrixGraph returns the Kneser graph K(n,k) modelled by an adjacency
+// matrix. Its vertices are the subsets of cardinality k of {0, ..., n-1} in
+// lexicographic order, and two of them are adjacent when they are disjoint.
+func KneserMatrixGraph(n, k int) (*StaticGraph, error) {
+	a, err := subsetsMatrix(n, k, 0)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// KneserListGraph returns the Kneser graph K(n,k) modelled by an adjacency
+// list, with the same labelling as KneserMatrixGraph.
+func KneserListGraph(n, k int) (*StaticGraph, error) {
+	a, err := subsetsMatrix(n, k, 0)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// JohnsonMatrixGraph returns the Johnson graph J(n,k) modelled by an adjacency
+// matrix. Its vertices are the subsets of cardinality k of {0, ..., n-1} in
+// lexicographic order, and two of them are adjacent when their intersection
+// has cardinality k-1.
+func JohnsonMatrixGraph(n, k int) (*StaticGraph, error) {
+	a, err := subsetsMatrix(n, k, k-1)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// JohnsonListGraph returns the Johnson graph J(n,k) modelled by an adjacency
+// list, with the same labelling as JohnsonMatrixGraph.
+func JohnsonListGraph(n, k int) (*StaticGraph, error) {
+	a, err := subsetsMatrix(n, k, k-1)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// A finiteField is the field with q = p^e elements. Each element is an integer
+// in [0, q) whose digits in base p are the coefficients (lowest degree first)
+// of a polynomial over the integers modulo p, and the product is taken modulo
+// an irreducible monic polynomial of degree e.
+type finiteField struct {
+	p, e, q int
+	modulus []int
+}
+
+// Returns the prime p and exponent e such that q = p^e, if they exist.
+func primePower(q int) (int, int, bool) {
+	if q < 2 {
+		return 0, 0, false
+	}
+	p := 2
+	for q%p != 0 {
+		p++
+	}
+	e := 0
+	for r := q; r > 1; r /= p {
+		if r%p != 0 {
+			return 0, 0, false
+		}
+		e++
+	}
+	return p, e, true
+}
+
+// Returns the remainder of the division of polynomial f by the monic
+// polynomial g, with coefficients modulo p.
+func polynomialRemainder(f, g []int, p int) []int {
+	r := make([]int, len(f))
+	copy(r, f)
+	for d := len(r) - 1; d >= len(g)-1; d-- {
+		c := r[d]
+		if c == 0 {
+			continue
+		}
+		for i := range g {
+			k := d - (len(g) - 1) + i
+			r[k] = ((r[k]-c*g[i])%p + p) % p
+		}
+	}
+	return r[:len(g)-1]
+}
+
+// Returns the monic polynomial of degree d over the integers modulo p whose
+// lower coefficients are the digits in base p of x.
+func monicPolynomial(x, d, p int) []int {
+	f := make([]int, d+1)
+	for i := 0; i < d; i++ {
+		f[i] = x % p
+		x /= p
+	}
+	f[d] = 1
+	return f
+}
+
+// Builds the finite field of order q, if q is a prime power.
+func newFiniteField(q int) (*finiteField, bool) {
+	p, e, ok := primePower(q)
+	if !ok {
+		return nil, false
+	}
+	field := &finiteField{p: p, e: e, q: q, modulus: nil}
+	pe := q
+	for x := 0; x < pe && field.modulus == nil; x++ {
+		f := monicPolynomial(x, e, p)
+		irreducible := true
+		for d := 1; 2*d <= e && irreducible; d++ {
+			pd := 1
+			for i := 0; i < d; i++ {
+				pd *= p
+			}
+			for y := 0; y < pd && irreducible; y++ {
+				zero := true
+				for _, c := range polynomialRemainder(f, monicPolynomial(y, d, p), p) {
+					if c != 0 {
+						zero = false
+					}
+				}
+				irreducible = !zero
+			}
+		}
+		if irreducible {
+			field.modulus = f
+		}
+	}
+	return field, true
+}
+
+// Returns the digits in base p of an element of the field.
+func (f *finiteField) digits(x int) []int {
+	d := make([]int, f.e)
+	for i := range d {
+		d[i] = x % f.p
+		x /= f.p
+	}
+	return d
+}
+
+// Returns the element of the field with the given digits in base p.
+func (f *finiteField) element(d []int) int {
+	x := 0
+	for i := len(d) - 1; i >= 0; i-- {
+		x = x*f.p + d[i]
+	}
+	return x
+}
+
+// Returns the difference x - y of two elements of the field.
+func (f *finiteField) sub(x, y int) int {
+	dx, dy := f.digits(x), f.digits(y)
+	for i := range dx {
+		dx[i] = ((dx[i]-dy[i])%f.p + f.p) % f.p
+	}
+	return f.element(dx)
+}
+
+// Returns the product of two elements of the field.
+func (f *finiteField) mul(x, y int) int {
+	dx, dy := f.digits(x), f.digits(y)
+	c := make([]int, 2*f.e)
+	for i := range dx {
+		for j := range dy {
+			c[i+j] = (c[i+j] + dx[i]*dy[j]) % f.p
+		}
+	}
+	return f.element(polynomialRemainder(c, f.modulus, f.p))
+}
+
+// Makes the adjacency matrix of the Paley graph of order q.
+func paleyMatrix(q int) (graph.AdjacencyMatrix, error) {
+	field, ok := newFiniteField(q)
+	if !ok || q%4 != 1 {
+		return nil, InvalidPaleyOrder
+	}
+	squares := make([]bool, q)
+	for x := 1; x < q; x++ {
+		squares[field.mul(x, x)] = true
+	}
+	a := emptyMatrix(q)
+	for i := 0; i < q; i++ {
+		for j := i + 1; j < q; j++ {
+			if squares[field.sub(i, j)] {
+				addEdge(a, i, j)
+			}
+		}
+	}
+	return a, nil
+}
+
+// PaleyMatrixGraph returns the Paley graph of order q modelled by an adjacency
+// matrix, where q is a prime power congruent to 1 modulo 4. Its vertices are
+// the elements of the finite field of order q, and two of them are adjacent
+// when their difference is a non-zero square.
+func PaleyMatrixGraph(q int) (*StaticGraph, error) {
+	a, err := paleyMatrix(q)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// PaleyListGraph returns the Paley graph of order q modelled by an adjacency
+// list, where q is a prime power congruent to 1 modulo 4.
+func PaleyListGraph(q int) (*StaticGraph, error) {
+	a, err := paleyMatrix(q)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// Makes the adjacency matrix of the Möbius ladder with n rungs.
+func mobiusLadderMatrix(n int) (graph.AdjacencyMatrix, error) {
+	if n < 3 {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(2 * n)
+	for i := 0; i < 2*n; i++ {
+		addEdge(a, i, (i+1)%(2*n))
+	}
+	for i := 0; i < n; i++ {
+		addEdge(a, i, i+n)
+	}
+	return a, nil
+}
+
+// MobiusLadderMatrixGraph returns the Möbius ladder with n rungs modelled by an
+// adjacency matrix, where n >= 3. It is the cycle of order 2n in canonical
+// order, where each vertex i is also adjacent to the opposite vertex i+n (mod
+// 2n).
+func MobiusLadderMatrixGraph(n int) (*StaticGraph, error) {
+	a, err := mobiusLadderMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// MobiusLadderListGraph returns the Möbius ladder with n rungs modelled by an
+// adjacency list, with the same labelling as MobiusLadderMatrixGraph.
+func MobiusLadderListGraph(n int) (*StaticGraph, error) {
+	a, err := mobiusLadderMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// Makes the adjacency matrix of the prism over a cycle of order n.
+func prismMatrix(n int) (graph.AdjacencyMatrix, error) {
+	if n < 3 {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(2 * n)
+	for i := 0; i < n; i++ {
+		addEdge(a, i, (i+1)%n)
+		addEdge(a, n+i, n+(i+1)%n)
+		addEdge(a, i, n+i)
+	}
+	return a, nil
+}
+
+// PrismMatrixGraph returns the prism over a cycle of order n modelled by an
+// adjacency matrix, where n >= 3. Vertices 0, ..., n-1 and n, ..., 2n-1 form
+// two cycles in canonical order, and each vertex i is adjacent to n+i.
+func PrismMatrixGraph(n int) (*StaticGraph, error) {
+	a, err := prismMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// PrismListGraph returns the prism over a cycle of order n modelled by an
+// adjacency list, with the same labelling as PrismMatrixGraph.
+func PrismListGraph(n int) (*StaticGraph, error) {
+	a, err := prismMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// Makes the adjacency matrix of the m by n grid. If wrap is true, the rows
+// and columns are closed into cycles, obtaining a torus.
+func gridMatrix(m, n int, wrap bool) (graph.AdjacencyMatrix, error) {
+	if m < 0 || n < 0 || (wrap && (m < 3 || n < 3)) {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(m * n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if j+1 < n {
+				addEdge(a, i*n+j, i*n+j+1)
+			} else if wrap {
+				addEdge(a, i*n+j, i*n)
+			}
+			if i+1 < m {
+				addEdge(a, i*n+j, (i+1)*n+j)
+			} else if wrap {
+				addEdge(a, i*n+j, j)
+			}
+		}
+	}
+	return a, nil
+}
+
+// GridMatrixGraph returns the m by n grid modelled by an adjacency matrix,
+// where m, n >= 0. The vertex in row i and column j is labelled i*n+j.
+func GridMatrixGraph(m, n int) (*StaticGraph, error) {
+	a, err := gridMatrix(m, n, false)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// GridListGraph returns the m by n grid modelled by an adjacency list, where
+// m, n >= 0. The vertex in row i and column j is labelled i*n+j.
+func GridListGraph(m, n int) (*StaticGraph, error) {
+	a, err := gridMatrix(m, n, false)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// TorusMatrixGraph returns the m by n torus, the Cartesian product of cycles of
+// orders m and n, modelled by an adjacency matrix, where m, n >= 3. The vertex
+// in row i and column j is labelled i*n+j.
+func TorusMatrixGraph(m, n int) (*StaticGraph, error) {
+	a, err := gridMatrix(m, n, true)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// TorusListGraph returns the m by n torus, the Cartesian product of cycles of
+// orders m and n, modelled by an adjacency list, where m, n >= 3. The vertex
+// in row i and column j is labelled i*n+j.
+func TorusListGraph(m, n int) (*StaticGraph, error) {
+	a, err := gridMatrix(m, n, true)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// Makes the adjacency matrix of the friendship graph with n triangles.
+func friendshipMatrix(n int) (graph.AdjacencyMatrix, error) {
+	if n < 0 {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(2*n + 1)
+	for i := 0; i < n; i++ {
+		addEdge(a, 0, 2*i+1)
+		addEdge(a, 0, 2*i+2)
+		addEdge(a, 2*i+1, 2*i+2)
+	}
+	return a, nil
+}
+
+// FriendshipMatrixGraph returns the friendship graph with n triangles modelled
+// by an adjacency matrix, where n >= 0. All the triangles share vertex 0, and
+// the other vertices of the i-th triangle are 2i+1 and 2i+2.
+func FriendshipMatrixGraph(n int) (*StaticGraph, error) {
+	a, err := friendshipMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// FriendshipListGraph returns the friendship graph with n triangles modelled by
+// an adjacency list, with the same labelling as FriendshipMatrixGraph.
+func FriendshipListGraph(n int) (*StaticGraph, error) {
+	a, err := friendshipMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// IsFriendship checks whether a graph is a friendship graph with at least one
+// triangle.
+func IsFriendship(g Graph) bool {
+	a := graph.MatrixOf(g)
+	if len(a) < 3 || !isSimple(a) {
+		return false
+	}
+	hub := universalVertex(a)
+	if hub == -1 {
+		return false
+	}
+	for i := range a {
+		if i != hub && rowDegree(a[i]) != 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// Makes the adjacency matrix of the complete multipartite graph with parts of
+// the given cardinalities.
+func completeMultipartiteMatrix(parts []int) (graph.AdjacencyMatrix, error) {
+	n := 0
+	for _, p := range parts {
+		if p < 0 {
+			return nil, InvalidParameters
+		}
+		n += p
+	}
+	part := make([]int, 0, n)
+	for i, p := range parts {
+		for j := 0; j < p; j++ {
+			part = append(part, i)
+		}
+	}
+	a := emptyMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if part[i] != part[j] {
+				addEdge(a, i, j)
+			}
+		}
+	}
+	return a, nil
+}
+
+// CompleteMultipartiteMatrixGraph returns the complete multipartite graph with
+// parts of the given cardinalities modelled by an adjacency matrix. Vertices
+// are labelled consecutively part by part.
+func CompleteMultipartiteMatrixGraph(parts []int) (*StaticGraph, error) {
+	a, err := completeMultipartiteMatrix(parts)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// CompleteMultipartiteListGraph returns the complete multipartite graph with
+// parts of the given cardinalities modelled by an adjacency list. Vertices are
+// labelled consecutively part by part.
+func CompleteMultipartiteListGraph(parts []int) (*StaticGraph, error) {
+	a, err := completeMultipartiteMatrix(parts)
+	if err != nil {
+		return nil, err
+	}
+	return graph.NewFromList(matrixToList(a)), nil
+}
+
+// Returns the cardinalities of the parts of a complete multipartite graph, in
+// the order of their smallest vertex, or nil if the graph is not complete
+// multipartite. A graph is complete multipartite if and only if
+// non-adjacency is an equivalence relation on its vertices.
+func multipartiteParts(a graph.AdjacencyMatrix) []int {
+	if !isSimple(a) {
+		return nil
+	}
+	part := make([]int, len(a))
+	for i := range part {
+		part[i] = -1
+	}
+	parts := []int{}
+	for i := range a {
+		if part[i] != -1 {
+			continue
+		}
+		part[i] = len(parts)
+		size := 1
+		for j := i + 1; j < len(a); j++ {
+			if a[i][j] == 0 {
+				if part[j] != -1 {
+					return nil
+				}
+				part[j] = len(parts)
+				size++
+			}
+		}
+		parts = append(parts, size)
+	}
+	for i := range a {
+		for j := range a[i] {
+			if i != j && (a[i][j] == 0) != (part[i] == part[j]) {
+				return nil
+			}
+		}
+	}
+	return parts
+}
+
+// IsCompleteMultipartite checks whether a graph is a complete multipartite
+// graph, that is, whether its vertices can be partitioned into stable sets,
+// every two of them fully adjacent.
+func IsCompleteMultipartite(g Graph) bool {
+	return multipartiteParts(graph.MatrixOf(g)) != nil
+}
+
+// Returns the cardinalities of the parts of the Turán graph T(n,r).
+func turanParts(n, r int) ([]int, error) {
+	if n < 0 || r < 1 {
+		return nil, InvalidParameters
+	}
+	parts := make([]int, r)
+	for i := range parts {
+		parts[i] = n / r
+		if i < n%r {
+			parts[i]++
+		}
+	}
+	return parts, nil
+}
+
+// TuranMatrixGraph returns the Turán graph T(n,r) modelled by an adjacency
+// matrix: the complete r-partite graph of order n whose parts have
+// cardinalities as equal as possible. Larger parts come first.
+func TuranMatrixGraph(n, r int) (*StaticGraph, error) {
+	parts, err := turanParts(n, r)
+	if err != nil {
+		return nil, err
+	}
+	return CompleteMultipartiteMatrixGraph(parts)
+}
+
+// TuranListGraph returns the Turán graph T(n,r) modelled by an adjacency list,
+// with the same labelling as TuranMatrixGraph.
+func TuranListGraph(n, r int) (*StaticGraph, error) {
+	parts, err := turanParts(n, r)
+	if err != nil {
+		return nil, err
+	}
+	return CompleteMultipartiteListGraph(parts)
+}
+
+// IsTuran checks whether a graph is a Turán graph, a complete multipartite
+// graph whose parts have cardinalities differing by at most one.
+func IsTuran(g Graph) bool {
+	parts := multipartiteParts(graph.MatrixOf(g))
+	if parts == nil {
+		return false
+	}
+	for _, p := range parts {
+		for _, q := range parts {
+			if p-q > 1 {
+				return false
+			}
+		}
+	}
+	return true
+}
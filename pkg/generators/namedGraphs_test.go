@@ -0,0 +1,197 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Checks whether every vertex of a graph has degree k.
+func isRegular(g Graph, k int) bool {
+	for _, d := range g.DegreeSequence() {
+		if d != k {
+			return false
+		}
+	}
+	return true
+}
+
+// A family describes the expected parameters of a graph built both as a
+// matrix and as a list graph.
+type family struct {
+	name   string
+	matrix *StaticGraph
+	list   *StaticGraph
+	order  int
+	size   int
+	degree int
+}
+
+// TestNamedGraphs builds graphs of every family in both representations, and
+// checks that both representations agree and have the expected order, size
+// and, for regular families, degree.
+func TestNamedGraphs(t *testing.T) {
+	must := func(g *StaticGraph, err error) *StaticGraph {
+		if err != nil {
+			t.Fatalf("Didn't expect an error, got %v", err)
+		}
+		return g
+	}
+	families := []family{
+		{"W6", must(WheelMatrixGraph(6)), must(WheelListGraph(6)), 7, 12, -1},
+		{"K1,5", StarMatrixGraph(5), StarListGraph(5), 6, 5, -1},
+		{"Q4", HypercubeMatrixGraph(4), HypercubeListGraph(4), 16, 32, 4},
+		{"Petersen", PetersenMatrixGraph(), PetersenListGraph(), 10, 15, 3},
+		{"GP(7,3)", must(GeneralizedPetersenMatrixGraph(7, 3)),
+			must(GeneralizedPetersenListGraph(7, 3)), 14, 21, 3},
+		{"K(6,2)", must(KneserMatrixGraph(6, 2)), must(KneserListGraph(6, 2)),
+			15, 45, 6},
+		{"J(5,2)", must(JohnsonMatrixGraph(5, 2)), must(JohnsonListGraph(5, 2)),
+			10, 30, 6},
+		{"P(13)", must(PaleyMatrixGraph(13)), must(PaleyListGraph(13)), 13, 39, 6},
+		{"P(9)", must(PaleyMatrixGraph(9)), must(PaleyListGraph(9)), 9, 18, 4},
+		{"P(25)", must(PaleyMatrixGraph(25)), must(PaleyListGraph(25)), 25, 150, 12},
+		{"M8", must(MobiusLadderMatrixGraph(4)), must(MobiusLadderListGraph(4)), 8, 12, 3},
+		{"Prism5", must(PrismMatrixGraph(5)), must(PrismListGraph(5)), 10, 15, 3},
+		{"Grid3x4", must(GridMatrixGraph(3, 4)), must(GridListGraph(3, 4)), 12, 17, -1},
+		{"Torus3x4", must(TorusMatrixGraph(3, 4)), must(TorusListGraph(3, 4)), 12, 24, 4},
+		{"F4", must(FriendshipMatrixGraph(4)), must(FriendshipListGraph(4)), 9, 12, -1},
+		{"T(8,3)", must(TuranMatrixGraph(8, 3)), must(TuranListGraph(8, 3)),
+			8, 21, -1},
+		{"K2,3,4", must(CompleteMultipartiteMatrixGraph([]int{2, 3, 4})),
+			must(CompleteMultipartiteListGraph([]int{2, 3, 4})), 9, 26, -1},
+	}
+	for _, f := range families {
+		a, _ := f.matrix.Matrix()
+		if !isSimple(a) {
+			t.Errorf("%v: graph is not simple", f.name)
+		}
+		if b := graph.MatrixOf(f.list); !sliceutils.EqualByteMatrix(a, b) {
+			t.Errorf("%v: representations differ, %v and %v", f.name, a, b)
+		}
+		for _, g := range []*StaticGraph{f.matrix, f.list} {
+			if g.Order() != f.order {
+				t.Errorf("%v: expected order %v, got %v", f.name, f.order, g.Order())
+			}
+			if g.Size() != f.size {
+				t.Errorf("%v: expected size %v, got %v", f.name, f.size, g.Size())
+			}
+			if f.degree != -1 && !isRegular(g, f.degree) {
+				t.Errorf("%v: expected to be %v-regular", f.name, f.degree)
+			}
+		}
+	}
+
+	// The Paley graph of order 5 is the cycle of order 5.
+	if g, _ := PaleyMatrixGraph(5); !IsCycle(g) {
+		t.Errorf("P(5) is expected to be a cycle")
+	}
+	for _, q := range []int{3, 7, 15, 21, 0, 1} {
+		if _, err := PaleyMatrixGraph(q); err != InvalidPaleyOrder {
+			t.Errorf("Expected %v, got %v", InvalidPaleyOrder, err)
+		}
+	}
+	for _, p := range [][2]int{{2, 1}, {4, 2}, {6, 3}, {5, 0}} {
+		if _, err := GeneralizedPetersenMatrixGraph(p[0], p[1]); err != InvalidParameters {
+			t.Errorf("Expected %v, got %v", InvalidParameters, err)
+		}
+	}
+	if _, err := TuranMatrixGraph(5, 0); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, err := KneserListGraph(3, 4); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, err := CompleteMultipartiteMatrixGraph([]int{1, -1}); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	constructors := []func(int) (*StaticGraph, error){
+		WheelMatrixGraph, WheelListGraph, PrismMatrixGraph, PrismListGraph,
+		MobiusLadderMatrixGraph, MobiusLadderListGraph,
+	}
+	for _, construct := range constructors {
+		for _, n := range []int{-1, 0, 1, 2} {
+			if _, err := construct(n); err != InvalidParameters {
+				t.Errorf("Expected %v, got %v", InvalidParameters, err)
+			}
+		}
+	}
+	if _, err := FriendshipListGraph(-1); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if g, err := FriendshipMatrixGraph(0); err != nil || g.Order() != 1 {
+		t.Errorf("Expected the friendship graph with no triangles, got %v", err)
+	}
+	for _, p := range [][2]int{{-1, 3}, {3, -2}} {
+		if _, err := GridMatrixGraph(p[0], p[1]); err != InvalidParameters {
+			t.Errorf("Expected %v, got %v", InvalidParameters, err)
+		}
+		if _, err := TorusListGraph(p[0], p[1]); err != InvalidParameters {
+			t.Errorf("Expected %v, got %v", InvalidParameters, err)
+		}
+	}
+	if _, err := TorusMatrixGraph(2, 4); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if g, err := GridListGraph(0, 5); err != nil || g.Order() != 0 {
+		t.Errorf("Expected the empty grid, got %v", err)
+	}
+}
+
+// TestNamedGraphRecognisers checks each recogniser against the members of its
+// family and some graphs that do not belong to it.
+func TestNamedGraphRecognisers(t *testing.T) {
+	must := func(g *StaticGraph, err error) *StaticGraph {
+		if err != nil {
+			t.Fatalf("Didn't expect an error, got %v", err)
+		}
+		return g
+	}
+	petersen := PetersenMatrixGraph()
+	for n := 3; n < 10; n++ {
+		if !IsWheel(must(WheelMatrixGraph(n))) || !IsWheel(must(WheelListGraph(n))) {
+			t.Errorf("Wheel with %v spokes not recognised", n)
+		}
+	}
+	if IsWheel(must(FriendshipMatrixGraph(3))) || IsWheel(petersen) ||
+		IsWheel(CompleteMatrixGraph(5)) {
+		t.Errorf("Graph wrongly recognised as a wheel")
+	}
+	for n := 0; n < 10; n++ {
+		if !IsStar(StarMatrixGraph(n)) || !IsStar(StarListGraph(n)) {
+			t.Errorf("Star with %v leaves not recognised", n)
+		}
+	}
+	if IsStar(MatrixPath(4)) || IsStar(CompleteMatrixGraph(3)) {
+		t.Errorf("Graph wrongly recognised as a star")
+	}
+	for n := 1; n < 10; n++ {
+		if !IsFriendship(must(FriendshipMatrixGraph(n))) ||
+			!IsFriendship(must(FriendshipListGraph(n))) {
+			t.Errorf("Friendship graph with %v triangles not recognised", n)
+		}
+	}
+	if IsFriendship(must(WheelMatrixGraph(4))) || IsFriendship(StarMatrixGraph(4)) {
+		t.Errorf("Graph wrongly recognised as a friendship graph")
+	}
+	for n := 0; n < 10; n++ {
+		for r := 1; r < 5; r++ {
+			a, _ := TuranMatrixGraph(n, r)
+			b, _ := TuranListGraph(n, r)
+			if !IsTuran(a) || !IsTuran(b) {
+				t.Errorf("T(%v,%v) not recognised", n, r)
+			}
+		}
+	}
+	multipartite, _ := CompleteMultipartiteListGraph([]int{1, 3, 2, 4})
+	if !IsCompleteMultipartite(multipartite) || IsTuran(multipartite) {
+		t.Errorf("K1,3,2,4 misclassified")
+	}
+	if !IsCompleteMultipartite(CompleteBipartiteMatrixGraph(3, 5)) {
+		t.Errorf("K3,5 is expected to be complete multipartite")
+	}
+	if IsCompleteMultipartite(petersen) || IsCompleteMultipartite(MatrixPath(4)) {
+		t.Errorf("Graph wrongly recognised as complete multipartite")
+	}
+}
@@ -0,0 +1,212 @@
+package generators
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// GnpRandomGraph returns a random graph of order n in the Erdős–Rényi model
+// G(n,p), where each pair of vertices is adjacent independently with
+// probability p, where n >= 0 and 0 <= p <= 1. The graph is modelled by an
+// adjacency matrix.
+func GnpRandomGraph(n int, p float64, r *rand.Rand) (*StaticGraph, error) {
+	if n < 0 || !(p >= 0 && p <= 1) {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if r.Float64() < p {
+				addEdge(a, i, j)
+			}
+		}
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// GnmRandomGraph returns a random graph of order n in the Erdős–Rényi model
+// G(n,m), chosen uniformly among the graphs on n labelled vertices with
+// exactly m edges. The graph is modelled by an adjacency matrix.
+func GnmRandomGraph(n, m int, r *rand.Rand) (*StaticGraph, error) {
+	pairs := n * (n - 1) / 2
+	if n < 0 || m < 0 || m > pairs {
+		return nil, InvalidParameters
+	}
+
+	// Partial Fisher–Yates shuffle of the pairs, where only the displaced
+	// positions are stored.
+	swapped := make(map[int]int)
+	at := func(k int) int {
+		if v, ok := swapped[k]; ok {
+			return v
+		}
+		return k
+	}
+	a := emptyMatrix(n)
+	for k := 0; k < m; k++ {
+		l := k + r.Intn(pairs-k)
+		e := at(l)
+		swapped[l] = at(k)
+		i := 0
+		for e >= n-1-i {
+			e -= n - 1 - i
+			i++
+		}
+		addEdge(a, i, i+1+e)
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// RandomRegularGraph returns a random d-regular graph of order n, chosen
+// uniformly among the d-regular graphs on n labelled vertices, using the
+// pairing model: n*d points are randomly matched, and the matching is rejected
+// if it produces loops or parallel edges. The expected number of attempts
+// grows quickly with d, so this is intended for small degrees. The graph is
+// modelled by an adjacency matrix.
+func RandomRegularGraph(n, d int, r *rand.Rand) (*StaticGraph, error) {
+	if n < 0 || d < 0 || (d >= n && n > 0) || (n*d)%2 != 0 {
+		return nil, InvalidParameters
+	}
+	points := make([]int, n*d)
+	for i := range points {
+		points[i] = i / d
+	}
+	for {
+		r.Shuffle(len(points), func(i, j int) {
+			points[i], points[j] = points[j], points[i]
+		})
+		a := emptyMatrix(n)
+		simple := true
+		for i := 0; i < len(points) && simple; i += 2 {
+			u, v := points[i], points[i+1]
+			if u == v || a[u][v] != 0 {
+				simple = false
+			} else {
+				addEdge(a, u, v)
+			}
+		}
+		if simple {
+			return graph.NewFromMatrix(a), nil
+		}
+	}
+}
+
+// BarabasiAlbertGraph returns a random graph of order n grown by preferential
+// attachment, where 1 <= m < n. It starts with m isolated vertices, and each
+// new vertex is joined to m different existing vertices, chosen with
+// probability proportional to their degree (the first new vertex is joined to
+// the m initial ones). The graph is modelled by an adjacency matrix.
+func BarabasiAlbertGraph(n, m int, r *rand.Rand) (*StaticGraph, error) {
+	if m < 1 || m >= n {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(n)
+
+	// Every vertex appears in repeated as many times as its degree.
+	var repeated []int
+	targets := make([]int, m)
+	for i := range targets {
+		targets[i] = i
+	}
+	for v := m; v < n; v++ {
+		for _, w := range targets {
+			addEdge(a, v, w)
+			repeated = append(repeated, v, w)
+		}
+		chosen := make(map[int]bool)
+		targets = targets[:0]
+		for len(targets) < m {
+			w := repeated[r.Intn(len(repeated))]
+			if !chosen[w] {
+				chosen[w] = true
+				targets = append(targets, w)
+			}
+		}
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// WattsStrogatzGraph returns a random small-world graph of order n. It starts
+// with a ring where each vertex is adjacent to its k nearest neighbours, where
+// k is even and k < n, and then each edge (i, i+j), for 1 <= j <= k/2, is
+// rewired with probability beta to (i, w), where 0 <= beta <= 1 and w is
+// chosen uniformly among the vertices that are not already adjacent to i. The
+// graph is modelled by an adjacency matrix.
+func WattsStrogatzGraph(n, k int, beta float64, r *rand.Rand) (*StaticGraph, error) {
+	if k < 0 || k%2 != 0 || k >= n || !(beta >= 0 && beta <= 1) {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(n)
+	degree := make([]int, n)
+	for i := 0; i < n; i++ {
+		for j := 1; j <= k/2; j++ {
+			addEdge(a, i, (i+j)%n)
+		}
+		degree[i] = k
+	}
+	for j := 1; j <= k/2; j++ {
+		for i := 0; i < n; i++ {
+			v := (i + j) % n
+			if a[i][v] == 0 || degree[i] == n-1 || r.Float64() >= beta {
+				continue
+			}
+			w := r.Intn(n)
+			for w == i || a[i][w] != 0 {
+				w = r.Intn(n)
+			}
+			a[i][v], a[v][i] = 0, 0
+			addEdge(a, i, w)
+			degree[v]--
+			degree[w]++
+		}
+	}
+	return graph.NewFromMatrix(a), nil
+}
+
+// RandomGeometricGraph returns a random geometric graph of order n, along with
+// the positions of its vertices. Each vertex is a point chosen uniformly in
+// the unit square, and two vertices are adjacent when their Euclidean distance
+// is at most radius, where n >= 0 and radius >= 0. The graph is modelled by an
+// adjacency matrix.
+func RandomGeometricGraph(n int, radius float64, r *rand.Rand) (*StaticGraph, [][2]float64, error) {
+	if n < 0 || !(radius >= 0) {
+		return nil, nil, InvalidParameters
+	}
+	points := make([][2]float64, n)
+	for i := range points {
+		points[i] = [2]float64{r.Float64(), r.Float64()}
+	}
+	a := emptyMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			dx := points[i][0] - points[j][0]
+			dy := points[i][1] - points[j][1]
+			if math.Sqrt(dx*dx+dy*dy) <= radius {
+				addEdge(a, i, j)
+			}
+		}
+	}
+	return graph.NewFromMatrix(a), points, nil
+}
+
+// RandomTournament returns a random tournament of order n, where n >= 0 and
+// each pair of vertices is joined by an arc in one of the two directions, each
+// with probability 1/2. The tournament is modelled by an adjacency matrix.
+func RandomTournament(n int, r *rand.Rand) (*StaticDigraph, error) {
+	if n < 0 {
+		return nil, InvalidParameters
+	}
+	a := emptyMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if r.Intn(2) == 0 {
+				a[i][j] = 1
+			} else {
+				a[j][i] = 1
+			}
+		}
+	}
+	return graph.NewDigraphFromMatrix(a), nil
+}
@@ -0,0 +1,131 @@
+package generators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+)
+
+// TestRandomGraphsReproducible checks that every random generator produces the
+// same graph when given sources with the same seed, and that the graphs are
+// simple.
+func TestRandomGraphsReproducible(t *testing.T) {
+	generators := []func(r *rand.Rand) Graph{
+		func(r *rand.Rand) Graph { g, _ := GnpRandomGraph(30, 0.3, r); return g },
+		func(r *rand.Rand) Graph { g, _ := GnmRandomGraph(30, 100, r); return g },
+		func(r *rand.Rand) Graph { g, _ := RandomRegularGraph(30, 3, r); return g },
+		func(r *rand.Rand) Graph { g, _ := BarabasiAlbertGraph(30, 2, r); return g },
+		func(r *rand.Rand) Graph { g, _ := WattsStrogatzGraph(30, 4, 0.2, r); return g },
+		func(r *rand.Rand) Graph { g, _, _ := RandomGeometricGraph(30, 0.3, r); return g },
+		func(r *rand.Rand) Graph { d, _ := RandomTournament(30, r); return d },
+	}
+	for i, generate := range generators {
+		a, _ := generate(rand.New(rand.NewSource(31))).Matrix()
+		b, _ := generate(rand.New(rand.NewSource(31))).Matrix()
+		if !sliceutils.EqualByteMatrix(a, b) {
+			t.Errorf("Generator %v is not reproducible", i)
+		}
+		c, _ := generate(rand.New(rand.NewSource(32))).Matrix()
+		if sliceutils.EqualByteMatrix(a, c) {
+			t.Errorf("Generator %v gave the same graph for different seeds", i)
+		}
+		if i < len(generators)-1 && !isSimple(a) {
+			t.Errorf("Generator %v did not produce a simple graph", i)
+		}
+	}
+}
+
+// TestRandomGraphsParameters checks the order, size and degrees of random
+// graphs, and that invalid parameters are rejected.
+func TestRandomGraphsParameters(t *testing.T) {
+	r := rand.New(rand.NewSource(31))
+	for k := 0; k < 20; k++ {
+		n := 2 + r.Intn(20)
+		m := r.Intn(n * (n - 1) / 2)
+		g, _ := GnmRandomGraph(n, m, r)
+		if g.Order() != n || g.Size() != m {
+			t.Errorf("Expected order %v and size %v, got %v and %v", n, m,
+				g.Order(), g.Size())
+		}
+		d := r.Intn(5)
+		if n*d%2 == 0 && d < n {
+			g, _ = RandomRegularGraph(n, d, r)
+			if !isRegular(g, d) {
+				t.Errorf("Expected a %v-regular graph, got %v", d, g.DegreeSequence())
+			}
+		}
+		m = 1 + r.Intn(n-1)
+		g, _ = BarabasiAlbertGraph(n, m, r)
+		if g.Size() != m*(n-m) {
+			t.Errorf("Expected size %v, got %v", m*(n-m), g.Size())
+		}
+		if n > 4 {
+			g, _ = WattsStrogatzGraph(n, 4, 0.5, r)
+			if g.Size() != 2*n {
+				t.Errorf("Expected size %v, got %v", 2*n, g.Size())
+			}
+		}
+		tournament, _ := RandomTournament(n, r)
+		a, _ := tournament.Matrix()
+		for i := range a {
+			for j := range a {
+				if i != j && a[i][j]+a[j][i] != 1 {
+					t.Errorf("Vertices %v and %v are not joined by exactly one arc", i, j)
+				}
+			}
+		}
+		radius := r.Float64()
+		g, points, _ := RandomGeometricGraph(n, radius, r)
+		a, _ = g.Matrix()
+		for i := range a {
+			for j := range a {
+				dx := points[i][0] - points[j][0]
+				dy := points[i][1] - points[j][1]
+				near := i != j && math.Sqrt(dx*dx+dy*dy) <= radius
+				if near != (a[i][j] == 1) {
+					t.Errorf("Adjacency of %v and %v does not match their distance", i, j)
+				}
+			}
+		}
+	}
+	if g, _ := GnpRandomGraph(10, 1, r); !IsComplete(g) {
+		t.Errorf("G(10,1) is expected to be complete")
+	}
+	if g, _ := WattsStrogatzGraph(12, 4, 0, r); !IsCirculant(g) {
+		t.Errorf("Watts–Strogatz graph without rewiring is expected to be circulant")
+	}
+	if _, err := GnmRandomGraph(4, 7, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, err := RandomRegularGraph(5, 3, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, err := BarabasiAlbertGraph(5, 5, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, err := WattsStrogatzGraph(10, 3, 0.1, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	for _, p := range []float64{-0.1, 1.5, math.NaN()} {
+		if _, err := GnpRandomGraph(5, p, r); err != InvalidParameters {
+			t.Errorf("Expected %v, got %v", InvalidParameters, err)
+		}
+		if _, err := WattsStrogatzGraph(10, 4, p, r); err != InvalidParameters {
+			t.Errorf("Expected %v, got %v", InvalidParameters, err)
+		}
+	}
+	if _, err := GnpRandomGraph(-1, 0.5, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, _, err := RandomGeometricGraph(-1, 0.5, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, _, err := RandomGeometricGraph(5, -0.5, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+	if _, err := RandomTournament(-1, r); err != InvalidParameters {
+		t.Errorf("Expected %v, got %v", InvalidParameters, err)
+	}
+}
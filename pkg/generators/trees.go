@@ -0,0 +1,250 @@
+package generators
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Trees are represented by their level sequences: the depths of the vertices
+// of a rooted tree listed in preorder, so the root is the only vertex of
+// depth 0 and the parent of each vertex is the last previous vertex one level
+// above it.
+
+// Makes the adjacency list of the tree with a given level sequence, where the
+// vertices are labelled in preorder, starting with the root at 0.
+func levelSequenceToList(layout []int, offset int) graph.AdjacencyList {
+	l := make([][]int, len(layout))
+	for i := range l {
+		l[i] = make([]int, 0)
+	}
+	last := make([]int, len(layout))
+	for i, depth := range layout {
+		last[depth] = i
+		if depth > 0 {
+			parent := last[depth-1]
+			l[parent] = append(l[parent], i+offset)
+			l[i] = append(l[i], parent+offset)
+		}
+	}
+	return l
+}
+
+// Returns the level sequence of the rooted tree that follows a given one in
+// the order of Beyer and Hedetniemi, or nil if it is the last one. The
+// successor is obtained by replacing the suffix starting at position p (by
+// default the last vertex not at depth 1) with copies of the subtree rooted at
+// the parent of that vertex.
+func nextRootedTree(predecessor []int, p int) []int {
+	if p == -1 {
+		p = len(predecessor) - 1
+		for predecessor[p] == 1 {
+			p--
+		}
+	}
+	if p <= 0 {
+		return nil
+	}
+	q := p - 1
+	for predecessor[q] != predecessor[p]-1 {
+		q--
+	}
+	result := make([]int, len(predecessor))
+	copy(result, predecessor)
+	for i := p; i < len(result); i++ {
+		result[i] = result[i-p+q]
+	}
+	return result
+}
+
+// Splits the level sequence of a rooted tree into the level sequence of the
+// leftmost subtree of the root (rooted at the first child of the root), and
+// the level sequence of the tree with that subtree removed.
+func splitTree(layout []int) ([]int, []int) {
+	m := len(layout)
+	for i := 2; i < len(layout); i++ {
+		if layout[i] == 1 {
+			m = i
+			break
+		}
+	}
+	left := make([]int, 0, m-1)
+	for _, depth := range layout[1:m] {
+		left = append(left, depth-1)
+	}
+	rest := append([]int{0}, layout[m:]...)
+	return left, rest
+}
+
+// Returns the maximum of a non-empty slice.
+func maxInt(s []int) int {
+	m := s[0]
+	for _, v := range s {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Returns whether slice a is lexicographically greater than slice b.
+func lexicographicallyGreater(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] > b[i]
+		}
+	}
+	return len(a) > len(b)
+}
+
+// One step of the algorithm of Wright, Richmond, Odlyzko and McKay. If the
+// candidate is the canonical level sequence of a free tree (rooted at its
+// centre, with its leftmost subtree no larger than the rest), it is returned;
+// otherwise, the next canonical level sequence is returned.
+func nextFreeTree(candidate []int) []int {
+	left, rest := splitTree(candidate)
+	leftHeight := maxInt(left)
+	restHeight := maxInt(rest)
+	valid := restHeight >= leftHeight
+	if valid && restHeight == leftHeight {
+		if len(left) > len(rest) {
+			valid = false
+		} else if len(left) == len(rest) && lexicographicallyGreater(left, rest) {
+			valid = false
+		}
+	}
+	if valid {
+		return candidate
+	}
+	p := len(left)
+	next := nextRootedTree(candidate, p)
+	if candidate[p] > 2 {
+		newLeft, _ := splitTree(next)
+		height := maxInt(newLeft)
+		for i := 0; i <= height; i++ {
+			next[len(next)-height-1+i] = i + 1
+		}
+	}
+	return next
+}
+
+// Calls f with the level sequence of every non-isomorphic free tree of order
+// n, where n >= 1.
+func freeTreeLayouts(n int, f func([]int)) {
+	if n < 1 {
+		return
+	} else if n < 3 {
+		layout := make([]int, n)
+		for i := range layout {
+			layout[i] = i
+		}
+		f(layout)
+		return
+	}
+
+	// Start at the path rooted at its centre.
+	layout := make([]int, 0, n)
+	for i := 0; i <= n/2; i++ {
+		layout = append(layout, i)
+	}
+	for i := 1; i < (n+1)/2; i++ {
+		layout = append(layout, i)
+	}
+	for layout != nil {
+		layout = nextFreeTree(layout)
+		if layout != nil {
+			f(layout)
+			layout = nextRootedTree(layout, -1)
+		}
+	}
+}
+
+// FreeTrees calls f with every free tree of order n, where n >= 1, up to
+// isomorphism, using the constant time algorithm of Wright, Richmond, Odlyzko
+// and McKay. The trees are modelled by adjacency lists.
+func FreeTrees(n int, f func(*StaticGraph)) {
+	freeTreeLayouts(n, func(layout []int) {
+		f(graph.NewFromList(levelSequenceToList(layout, 0)))
+	})
+}
+
+// RootedTrees calls f with every rooted tree of order n, where n >= 1, up to
+// isomorphism (preserving the root), using the constant time algorithm of
+// Beyer and Hedetniemi. The root of every tree is vertex 0, and the trees are
+// modelled by adjacency lists.
+func RootedTrees(n int, f func(*StaticGraph)) {
+	if n < 1 {
+		return
+	}
+	layout := make([]int, n)
+	for i := range layout {
+		layout[i] = i
+	}
+	for layout != nil {
+		f(graph.NewFromList(levelSequenceToList(layout, 0)))
+		layout = nextRootedTree(layout, -1)
+	}
+}
+
+// Calls f with every partition of n into parts of size at most largest, as a
+// non-increasing slice.
+func integerPartitions(n, largest int, f func([]int)) {
+	var parts []int
+	var extend func(remaining, largest int)
+	extend = func(remaining, largest int) {
+		if remaining == 0 {
+			f(parts)
+			return
+		}
+		if largest > remaining {
+			largest = remaining
+		}
+		for p := largest; p >= 1; p-- {
+			parts = append(parts, p)
+			extend(remaining-p, p)
+			parts = parts[:len(parts)-1]
+		}
+	}
+	extend(n, largest)
+}
+
+// Forests calls f with every forest of order n, where n >= 1, up to
+// isomorphism. Each forest is a multiset of free trees whose orders add up to
+// n; its components are labelled consecutively, from the largest to the
+// smallest. The forests are modelled by adjacency lists.
+func Forests(n int, f func(*StaticGraph)) {
+	if n < 1 {
+		return
+	}
+	trees := make([][][]int, n+1)
+	for k := 1; k <= n; k++ {
+		freeTreeLayouts(k, func(layout []int) {
+			l := make([]int, len(layout))
+			copy(l, layout)
+			trees[k] = append(trees[k], l)
+		})
+	}
+	integerPartitions(n, n, func(parts []int) {
+		// Trees with the same order are chosen with non-decreasing indices,
+		// so each multiset is produced once.
+		chosen := make([]int, len(parts))
+		var choose func(i int)
+		choose = func(i int) {
+			if i == len(parts) {
+				list := make([][]int, 0, n)
+				for j, p := range parts {
+					list = append(list, levelSequenceToList(trees[p][chosen[j]], len(list))...)
+				}
+				f(graph.NewFromList(list))
+				return
+			}
+			start := 0
+			if i > 0 && parts[i-1] == parts[i] {
+				start = chosen[i-1]
+			}
+			for k := start; k < len(trees[parts[i]]); k++ {
+				chosen[i] = k
+				choose(i + 1)
+			}
+		}
+		choose(0)
+	})
+}
@@ -0,0 +1,145 @@
+package generators
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Returns the AHU encoding of the tree spanned from v, avoiding its parent.
+func rootedEncoding(l [][]int, v, parent int) string {
+	var children []string
+	for _, w := range l[v] {
+		if w != parent {
+			children = append(children, rootedEncoding(l, w, v))
+		}
+	}
+	sort.Strings(children)
+	return "(" + strings.Join(children, "") + ")"
+}
+
+// Returns a string which is the same for two forests if and only if they are
+// isomorphic, by encoding every component from each of its vertices.
+func forestEncoding(l [][]int) string {
+	seen := make([]bool, len(l))
+	var components []string
+	for v := range l {
+		if seen[v] {
+			continue
+		}
+		component := []int{v}
+		seen[v] = true
+		for i := 0; i < len(component); i++ {
+			for _, w := range l[component[i]] {
+				if !seen[w] {
+					seen[w] = true
+					component = append(component, w)
+				}
+			}
+		}
+		best := ""
+		for _, u := range component {
+			if e := rootedEncoding(l, u, -1); best == "" || e < best {
+				best = e
+			}
+		}
+		components = append(components, best)
+	}
+	sort.Strings(components)
+	return strings.Join(components, "")
+}
+
+// Returns whether an adjacency list describes a forest.
+func isForestList(l [][]int) bool {
+	edges := 0
+	for _, neighbours := range l {
+		edges += len(neighbours)
+	}
+	components := 0
+	seen := make([]bool, len(l))
+	for v := range l {
+		if seen[v] {
+			continue
+		}
+		components++
+		stack := []int{v}
+		seen[v] = true
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, w := range l[u] {
+				if !seen[w] {
+					seen[w] = true
+					stack = append(stack, w)
+				}
+			}
+		}
+	}
+	return edges/2 == len(l)-components
+}
+
+// TestFreeTrees checks the number of free trees against OEIS A000055, and that
+// they are pairwise non-isomorphic trees.
+func TestFreeTrees(t *testing.T) {
+	expected := []int{0, 1, 1, 1, 2, 3, 6, 11, 23, 47, 106, 235, 551, 1301}
+	for n := 1; n < len(expected); n++ {
+		count := 0
+		encodings := make(map[string]bool)
+		FreeTrees(n, func(g *StaticGraph) {
+			l, _ := g.List()
+			if g.Order() != n || g.Size() != n-1 || !isForestList(l) {
+				t.Errorf("Expected a tree of order %v, got %v", n, l)
+			}
+			encodings[forestEncoding(l)] = true
+			count++
+		})
+		if count != expected[n] || len(encodings) != expected[n] {
+			t.Errorf("Expected %v free trees of order %v, got %v (%v distinct)",
+				expected[n], n, count, len(encodings))
+		}
+	}
+}
+
+// TestRootedTrees checks the number of rooted trees against OEIS A000081, and
+// that they are pairwise non-isomorphic when rooted at vertex 0.
+func TestRootedTrees(t *testing.T) {
+	expected := []int{0, 1, 1, 2, 4, 9, 20, 48, 115, 286, 719}
+	for n := 1; n < len(expected); n++ {
+		count := 0
+		encodings := make(map[string]bool)
+		RootedTrees(n, func(g *StaticGraph) {
+			l, _ := g.List()
+			if g.Order() != n || g.Size() != n-1 || !isForestList(l) {
+				t.Errorf("Expected a tree of order %v, got %v", n, l)
+			}
+			encodings[rootedEncoding(l, 0, -1)] = true
+			count++
+		})
+		if count != expected[n] || len(encodings) != expected[n] {
+			t.Errorf("Expected %v rooted trees of order %v, got %v (%v distinct)",
+				expected[n], n, count, len(encodings))
+		}
+	}
+}
+
+// TestForests checks the number of forests against OEIS A005195, and that they
+// are pairwise non-isomorphic.
+func TestForests(t *testing.T) {
+	expected := []int{1, 1, 2, 3, 6, 10, 20, 37, 76, 153, 329}
+	for n := 1; n < len(expected); n++ {
+		count := 0
+		encodings := make(map[string]bool)
+		Forests(n, func(g *StaticGraph) {
+			l, _ := g.List()
+			if g.Order() != n || !isForestList(l) {
+				t.Errorf("Expected a forest of order %v, got %v", n, l)
+			}
+			encodings[forestEncoding(l)] = true
+			count++
+		})
+		if count != expected[n] || len(encodings) != expected[n] {
+			t.Errorf("Expected %v forests of order %v, got %v (%v distinct)",
+				expected[n], n, count, len(encodings))
+		}
+	}
+}
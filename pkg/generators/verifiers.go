@@ -128,3 +128,123 @@ func AreFullyNonAdjacent(g Graph, x, y []int) bool {
 	}
 	return true
 }
+
+// Returns whether a collection of vertices of a graph has no repeated
+// vertices, and marks them in a slice indexed by the vertices of the graph.
+func markVertices(g Graph, vertices []int) ([]bool, bool) {
+	if !sliceutils.WithinIntervalSlice(vertices, 0, g.Order()) {
+		return nil, false
+	}
+	marked := make([]bool, g.Order())
+	for _, v := range vertices {
+		if marked[v] {
+			return nil, false
+		}
+		marked[v] = true
+	}
+	return marked, true
+}
+
+// Returns whether every vertex of a graph has a neighbour marked in a slice,
+// or is marked itself when closed is true.
+func dominates(g Graph, marked []bool, closed bool) bool {
+	for v := 0; v < g.Order(); v++ {
+		if closed && marked[v] {
+			continue
+		}
+		dominated := false
+		for w := range g.NeighboursSet(v).Items() {
+			if w != v && marked[w] {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDominatingSet receives a graph and a collection (subset) of vertices of the
+// graph, and verifies whether every vertex of the graph is in the collection or
+// adjacent to one of its vertices.
+func IsDominatingSet(g Graph, vertices []int) bool {
+	marked, ok := markVertices(g, vertices)
+	return ok && dominates(g, marked, true)
+}
+
+// IsTotalDominatingSet receives a graph and a collection (subset) of vertices of
+// the graph, and verifies whether every vertex of the graph, including those in
+// the collection, is adjacent to one of its vertices.
+func IsTotalDominatingSet(g Graph, vertices []int) bool {
+	marked, ok := markVertices(g, vertices)
+	return ok && dominates(g, marked, false)
+}
+
+// IsIndependentDominatingSet receives a graph and a collection (subset) of
+// vertices of the graph, and verifies whether it is both stable and dominating.
+func IsIndependentDominatingSet(g Graph, vertices []int) bool {
+	return IsDominatingSet(g, vertices) && IsStable(g, vertices)
+}
+
+// IsVertexCover receives a graph and a collection (subset) of vertices of the
+// graph, and verifies whether every edge of the graph has an endpoint in the
+// collection.
+func IsVertexCover(g Graph, vertices []int) bool {
+	marked, ok := markVertices(g, vertices)
+	if !ok {
+		return false
+	}
+	for v := 0; v < g.Order(); v++ {
+		if marked[v] {
+			continue
+		}
+		for w := range g.NeighboursSet(v).Items() {
+			if !marked[w] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsFeedbackVertexSet receives a graph and a collection (subset) of vertices of
+// the graph, and verifies whether the remaining vertices induce a forest, that
+// is, whether every cycle of the graph has a vertex in the collection. Loops are
+// cycles, while multiple edges are ignored.
+func IsFeedbackVertexSet(g Graph, vertices []int) bool {
+	marked, ok := markVertices(g, vertices)
+	if !ok {
+		return false
+	}
+	// Union-find over the remaining vertices, where an edge joining two
+	// vertices that are already connected closes a cycle.
+	parent := make([]int, g.Order())
+	for v := range parent {
+		parent[v] = v
+	}
+	var find func(v int) int
+	find = func(v int) int {
+		if parent[v] != v {
+			parent[v] = find(parent[v])
+		}
+		return parent[v]
+	}
+	for v := 0; v < g.Order(); v++ {
+		if marked[v] {
+			continue
+		}
+		for w := range g.NeighboursSet(v).Items() {
+			if marked[w] || w > v {
+				continue
+			}
+			rv, rw := find(v), find(w)
+			if w == v || rv == rw {
+				return false
+			}
+			parent[rv] = rw
+		}
+	}
+	return true
+}
@@ -241,3 +241,69 @@ func TestAreFullyNonAdjacent(t *testing.T) {
 		)
 	}
 }
+
+// TestDominatingSets checks the domination verifiers on a path of order 5,
+// modelled by an adjacency list and by an adjacency matrix.
+func TestDominatingSets(t *testing.T) {
+	list := [][]int{{1}, {0, 2}, {1, 3}, {2, 4}, {3}}
+	graphs := []Graph{graph.NewFromList(list), graph.NewFromMatrix(graph.MatrixOf(graph.NewFromList(list)))}
+	tests := []struct {
+		vertices                       []int
+		dominating, total, independent bool
+	}{
+		{[]int{1, 3}, true, false, true},
+		{[]int{0, 2, 4}, true, false, true},
+		{[]int{1, 2, 3}, true, true, false},
+		{[]int{1, 2}, false, false, false},
+		{[]int{1, 3, 3}, false, false, false},
+		{[]int{1, 5}, false, false, false},
+	}
+	for _, g := range graphs {
+		for _, test := range tests {
+			if IsDominatingSet(g, test.vertices) != test.dominating {
+				t.Errorf("Expected %v for %v, got %v", test.dominating, test.vertices,
+					!test.dominating)
+			}
+			if IsTotalDominatingSet(g, test.vertices) != test.total {
+				t.Errorf("Expected %v for %v, got %v", test.total, test.vertices, !test.total)
+			}
+			if IsIndependentDominatingSet(g, test.vertices) != test.independent {
+				t.Errorf("Expected %v for %v, got %v", test.independent, test.vertices,
+					!test.independent)
+			}
+		}
+	}
+}
+
+// TestVertexCoversAndFeedbackSets checks the vertex cover and feedback vertex
+// set verifiers on two triangles sharing vertex 2, and on a loop.
+func TestVertexCoversAndFeedbackSets(t *testing.T) {
+	list := [][]int{{1, 2}, {0, 2}, {0, 1, 3, 4}, {2, 4}, {2, 3}}
+	graphs := []Graph{graph.NewFromList(list), graph.NewFromMatrix(graph.MatrixOf(graph.NewFromList(list)))}
+	tests := []struct {
+		vertices        []int
+		cover, feedback bool
+	}{
+		{[]int{2}, false, true},
+		{[]int{0, 3}, false, true},
+		{[]int{0, 2, 3}, true, true},
+		{[]int{0, 1}, false, false},
+		{[]int{}, false, false},
+		{[]int{0, 1, 2, 3, 4}, true, true},
+	}
+	for _, g := range graphs {
+		for _, test := range tests {
+			if IsVertexCover(g, test.vertices) != test.cover {
+				t.Errorf("Expected %v for %v, got %v", test.cover, test.vertices, !test.cover)
+			}
+			if IsFeedbackVertexSet(g, test.vertices) != test.feedback {
+				t.Errorf("Expected %v for %v, got %v", test.feedback, test.vertices,
+					!test.feedback)
+			}
+		}
+	}
+	loop := graph.NewFromMatrix([][]byte{{1, 1}, {1, 0}})
+	if IsFeedbackVertexSet(loop, []int{1}) || !IsFeedbackVertexSet(loop, []int{0}) {
+		t.Errorf("Expected a loop to be a cycle")
+	}
+}
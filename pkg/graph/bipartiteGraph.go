@@ -0,0 +1,59 @@
+package graph
+
+// A BipartiteGraph represents a graph together with a bipartition of its
+// vertices, such that every edge joins vertices of different parts. The parts
+// are identified by the colours 0 and 1.
+type BipartiteGraph struct {
+	*StaticGraph
+	colours []int
+}
+
+// NewBipartiteGraph initializes a bipartite graph from a graph and the colour
+// (0 or 1) of each of its vertices. This method checks whether the colouring
+// is a bipartition of the graph; if it is not, it throws an error.
+func NewBipartiteGraph(g *StaticGraph, colours []int) (*BipartiteGraph, error) {
+	matrix := MatrixOf(g)
+	if len(colours) != len(matrix) {
+		return nil, InvalidBipartition
+	}
+	for i, row := range matrix {
+		if colours[i] != 0 && colours[i] != 1 {
+			return nil, InvalidBipartition
+		}
+		for j, w := range row {
+			if w != 0 && colours[i] == colours[j] {
+				return nil, InvalidBipartition
+			}
+		}
+	}
+	return NewBipartite(g, colours), nil
+}
+
+// NewBipartite initializes a bipartite graph from a graph and the colour (0 or
+// 1) of each of its vertices. This is an unsafe method, it does not check
+// whether the colouring is a bipartition of the graph.
+func NewBipartite(g *StaticGraph, colours []int) *BipartiteGraph {
+	return &BipartiteGraph{
+		StaticGraph: g,
+		colours:     colours,
+	}
+}
+
+// Colour returns the part (0 or 1) a vertex belongs to.
+func (b *BipartiteGraph) Colour(v int) int {
+	return b.colours[v]
+}
+
+// Colours returns the part (0 or 1) of every vertex.
+func (b *BipartiteGraph) Colours() []int {
+	return b.colours
+}
+
+// Parts returns the vertices of each part, in increasing order.
+func (b *BipartiteGraph) Parts() ([]int, []int) {
+	var parts [2][]int
+	for v, c := range b.colours {
+		parts[c] = append(parts[c], v)
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,30 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+)
+
+// TestNewBipartiteGraph checks that only bipartitions are accepted, and the
+// parts of a bipartite graph.
+func TestNewBipartiteGraph(t *testing.T) {
+	path := NewFromList([][]int{{1}, {0, 2}, {1, 3}, {2}})
+	b, err := NewBipartiteGraph(path, []int{0, 1, 0, 1})
+	if err != nil {
+		t.Errorf("Expected a bipartite graph, got %v", err)
+	}
+	first, second := b.Parts()
+	if !sliceutils.EqualIntSlice(first, []int{0, 2}) || !sliceutils.EqualIntSlice(second, []int{1, 3}) {
+		t.Errorf("Expected parts [0 2] and [1 3], got %v and %v", first, second)
+	}
+	if b.Colour(3) != 1 || b.Order() != 4 || b.Size() != 3 {
+		t.Errorf("Unexpected colour, order or size of %v", b)
+	}
+	invalid := [][]int{{0, 0, 1, 1}, {0, 1, 2, 1}, {0, 1, 0}}
+	for _, colours := range invalid {
+		if _, err := NewBipartiteGraph(path, colours); err != InvalidBipartition {
+			t.Errorf("Expected %v, got %v", InvalidBipartition, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package graph
+
+import (
+	"sort"
+)
+
+// An Edge represents an edge {U, V} of a graph, or an arc (U, V) of a
+// digraph, together with the number of parallel copies of it.
+type Edge struct {
+	U, V         int
+	Multiplicity int
+}
+
+// Returns the distinct entries of a row of an adjacency list, in increasing
+// order, with the number of times each one appears.
+func listRowCounts(row []int) ([]int, []int) {
+	sorted := make([]int, len(row))
+	copy(sorted, row)
+	sort.Ints(sorted)
+	var vertices, counts []int
+	for i, w := range sorted {
+		if i > 0 && w == sorted[i-1] {
+			counts[len(counts)-1]++
+		} else {
+			vertices = append(vertices, w)
+			counts = append(counts, 1)
+		}
+	}
+	return vertices, counts
+}
+
+// Edges returns the edges of the graph, each one once and with U <= V, in
+// lexicographic order. Loops are included, and parallel edges are collapsed
+// into a single edge with their multiplicity.
+func (g *StaticGraph) Edges() []Edge {
+	edges := []Edge{}
+	if g.matrix != nil {
+		for i, row := range g.matrix {
+			for j := i; j < len(row); j++ {
+				if row[j] != 0 {
+					edges = append(edges, Edge{U: i, V: j, Multiplicity: int(row[j])})
+				}
+			}
+		}
+		return edges
+	}
+	for i, row := range g.list {
+		vertices, counts := listRowCounts(row)
+		for k, w := range vertices {
+			if i <= w {
+				edges = append(edges, Edge{U: i, V: w, Multiplicity: counts[k]})
+			}
+		}
+	}
+	return edges
+}
+
+// HasEdge returns whether the vertices u and v are adjacent. It takes
+// constant time when the graph is modelled by its adjacency matrix, and time
+// proportional to the degree of u otherwise. In a digraph, it returns
+// whether there is an arc from u to v.
+func (g *StaticGraph) HasEdge(u, v int) bool {
+	if g.matrix != nil {
+		return g.matrix[u][v] != 0
+	}
+	for _, w := range g.list[u] {
+		if w == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Arcs returns the arcs of the digraph in lexicographic order. Parallel arcs
+// are collapsed into a single arc with their multiplicity.
+func (d *StaticDigraph) Arcs() []Edge {
+	arcs := []Edge{}
+	if d.matrix != nil {
+		for i, row := range d.matrix {
+			for j, n := range row {
+				if n != 0 {
+					arcs = append(arcs, Edge{U: i, V: j, Multiplicity: int(n)})
+				}
+			}
+		}
+		return arcs
+	}
+	for i, row := range d.list {
+		vertices, counts := listRowCounts(row)
+		for k, w := range vertices {
+			arcs = append(arcs, Edge{U: i, V: w, Multiplicity: counts[k]})
+		}
+	}
+	return arcs
+}
+
+// Edges returns the arcs of the digraph, as returned by Arcs.
+func (d *StaticDigraph) Edges() []Edge {
+	return d.Arcs()
+}
+
+// HasArc returns whether there is an arc from u to v in the digraph.
+func (d *StaticDigraph) HasArc(u, v int) bool {
+	return d.HasEdge(u, v)
+}
+
+// Builds the adjacency matrix of order n with the given edges, adding both
+// entries of each edge if symmetric is true.
+func edgesToMatrix(n int, edges []Edge, symmetric bool) (AdjacencyMatrix, error) {
+	matrix := make([][]byte, n)
+	for i := range matrix {
+		matrix[i] = make([]byte, n)
+	}
+	for _, e := range edges {
+		if e.U < 0 || e.U >= n || e.V < 0 || e.V >= n || e.Multiplicity < 1 ||
+			int(matrix[e.U][e.V])+e.Multiplicity > 255 {
+			return nil, InvalidEdge
+		}
+		matrix[e.U][e.V] += byte(e.Multiplicity)
+		if symmetric && e.U != e.V {
+			matrix[e.V][e.U] += byte(e.Multiplicity)
+		}
+	}
+	return matrix, nil
+}
+
+// NewGraphFromEdges initializes a graph of order n modelled by its adjacency
+// matrix, with the given edges. Repeated edges add up their multiplicities.
+// If an endpoint is not a vertex, a multiplicity is not positive, or an entry
+// of the matrix would exceed 255, it throws an error.
+func NewGraphFromEdges(n int, edges []Edge) (*StaticGraph, error) {
+	matrix, err := edgesToMatrix(n, edges, true)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromMatrix(matrix), nil
+}
+
+// NewDigraphFromEdges initializes a digraph of order n modelled by its
+// adjacency matrix, with the given arcs. Repeated arcs add up their
+// multiplicities. If an endpoint is not a vertex, a multiplicity is not
+// positive, or an entry of the matrix would exceed 255, it throws an error.
+func NewDigraphFromEdges(n int, arcs []Edge) (*StaticDigraph, error) {
+	matrix, err := edgesToMatrix(n, arcs, false)
+	if err != nil {
+		return nil, err
+	}
+	return NewDigraphFromMatrix(matrix), nil
+}
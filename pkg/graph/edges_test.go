@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEdges checks the edges of a multigraph with a loop, modelled both by its
+// adjacency matrix and by its adjacency list.
+func TestEdges(t *testing.T) {
+	matrix := [][]byte{
+		{1, 2, 0, 1},
+		{2, 0, 1, 0},
+		{0, 1, 0, 0},
+		{1, 0, 0, 0},
+	}
+	list := [][]int{{0, 3, 1, 1}, {2, 0, 0}, {1}, {0}}
+	expected := []Edge{{0, 0, 1}, {0, 1, 2}, {0, 3, 1}, {1, 2, 1}}
+	for _, g := range []*StaticGraph{NewFromMatrix(matrix), NewFromList(list)} {
+		if edges := g.Edges(); !reflect.DeepEqual(edges, expected) {
+			t.Errorf("Expected %v, got %v", expected, edges)
+		}
+		if !g.HasEdge(1, 0) || !g.HasEdge(0, 0) || g.HasEdge(2, 3) {
+			t.Errorf("Unexpected adjacencies in %v", matrix)
+		}
+	}
+	if edges := NewFromMatrix([][]byte{}).Edges(); len(edges) != 0 {
+		t.Errorf("Expected no edges, got %v", edges)
+	}
+}
+
+// TestArcs checks the arcs of a digraph, modelled both by its adjacency matrix
+// and by its adjacency list.
+func TestArcs(t *testing.T) {
+	matrix := [][]byte{
+		{0, 1, 0},
+		{0, 0, 2},
+		{1, 1, 0},
+	}
+	list := [][]int{{1}, {2, 2}, {1, 0}}
+	expected := []Edge{{0, 1, 1}, {1, 2, 2}, {2, 0, 1}, {2, 1, 1}}
+	digraphs := []*StaticDigraph{NewDigraphFromMatrix(matrix), NewDigraphFromList(list)}
+	for _, d := range digraphs {
+		if arcs := d.Arcs(); !reflect.DeepEqual(arcs, expected) {
+			t.Errorf("Expected %v, got %v", expected, arcs)
+		}
+		if edges := d.Edges(); !reflect.DeepEqual(edges, expected) {
+			t.Errorf("Expected %v, got %v", expected, edges)
+		}
+		if !d.HasArc(2, 1) || d.HasArc(1, 0) {
+			t.Errorf("Unexpected arcs in %v", matrix)
+		}
+	}
+}
+
+// TestNewGraphFromEdges checks that building a graph or a digraph from its
+// edges gives back the same edges, and that invalid edges are rejected.
+func TestNewGraphFromEdges(t *testing.T) {
+	edges := []Edge{{0, 1, 1}, {1, 2, 1}, {0, 1, 1}, {2, 2, 1}}
+	g, err := NewGraphFromEdges(3, edges)
+	expected := [][]byte{{0, 2, 0}, {2, 0, 1}, {0, 1, 1}}
+	if got, _ := g.Matrix(); err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	want := []Edge{{0, 1, 2}, {1, 2, 1}, {2, 2, 1}}
+	if got := g.Edges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	arcs := []Edge{{0, 1, 1}, {1, 0, 3}, {2, 0, 1}}
+	d, err := NewDigraphFromEdges(3, arcs)
+	if err != nil || !reflect.DeepEqual(d.Arcs(), []Edge{{0, 1, 1}, {1, 0, 3}, {2, 0, 1}}) {
+		t.Errorf("Expected %v, got %v", arcs, d.Arcs())
+	}
+
+	invalid := [][]Edge{
+		{{0, 3, 1}},
+		{{-1, 0, 1}},
+		{{0, 1, 0}},
+		{{0, 1, 200}, {0, 1, 100}},
+	}
+	for _, e := range invalid {
+		if _, err := NewGraphFromEdges(3, e); err != InvalidEdge {
+			t.Errorf("Expected %v, got %v", InvalidEdge, err)
+		}
+		if _, err := NewDigraphFromEdges(3, e); err != InvalidEdge {
+			t.Errorf("Expected %v, got %v", InvalidEdge, err)
+		}
+	}
+}
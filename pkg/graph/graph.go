@@ -15,7 +15,12 @@ type Graph interface {
 	// Order returns the number of vertices in the graph.
 	Order() int
 
-	// DegreeSequence returns the degree sequence of the graph.
+	// Degrees returns the degree of every vertex in the graph, indexed by
+	// vertex.
+	Degrees() []int
+
+	// DegreeSequence returns the degree sequence of the graph, in
+	// non-increasing order.
 	DegreeSequence() []int
 
 	// Size returns the size of the graph.
@@ -30,3 +35,27 @@ type Graph interface {
 	// NeighboursSet returns a set of the neighbours to a given vertex in the graph.
 	NeighboursSet(v int) *set.IntSet
 }
+
+// MatrixOf returns the adjacency matrix of a graph. If the graph is only
+// modelled by its adjacency list, the matrix is built from it, where every
+// occurrence of a vertex in a list adds one to the corresponding entry. If the
+// graph has neither representation, nil is returned.
+func MatrixOf(g Graph) AdjacencyMatrix {
+	if matrix, err := g.Matrix(); err == nil {
+		return matrix
+	}
+	list, err := g.List()
+	if err != nil {
+		return nil
+	}
+	matrix := make([][]byte, len(list))
+	for i := range matrix {
+		matrix[i] = make([]byte, len(list))
+	}
+	for i, v := range list {
+		for _, w := range v {
+			matrix[i][w]++
+		}
+	}
+	return matrix
+}
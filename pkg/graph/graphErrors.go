@@ -19,4 +19,7 @@ var (
 	invalidListError      = GraphError("Invalid adjacency list")
 	NilAdjacencyMatrix    = GraphError("Adjacency matrix is nil")
 	NilAdjacencyList      = GraphError("Adjacency list is nil")
+	InvalidBipartition    = GraphError("Colouring is not a bipartition of the graph")
+	InvalidPermutation    = GraphError("Invalid permutation of the vertices")
+	InvalidEdge           = GraphError("Invalid edge")
 )
@@ -0,0 +1,234 @@
+package graph
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// A Permutation is a bijection of the set {0, ..., n-1}, where p[v] is the
+// image of v. When used to relabel a graph, vertex v becomes vertex p[v].
+type Permutation []int
+
+// NewPermutation initializes a permutation from the images of 0, ..., n-1.
+// This method checks whether the images are a rearrangement of 0, ..., n-1; if
+// they are not, it throws an error.
+func NewPermutation(images []int) (Permutation, error) {
+	seen := make([]bool, len(images))
+	for _, w := range images {
+		if w < 0 || w >= len(images) || seen[w] {
+			return nil, InvalidPermutation
+		}
+		seen[w] = true
+	}
+	return Permutation(images), nil
+}
+
+// IdentityPermutation returns the identity permutation of n points.
+func IdentityPermutation(n int) Permutation {
+	p := make(Permutation, n)
+	for v := range p {
+		p[v] = v
+	}
+	return p
+}
+
+// RandomPermutation returns a permutation of n points chosen uniformly at
+// random using a given source of randomness.
+func RandomPermutation(n int, r *rand.Rand) Permutation {
+	return Permutation(r.Perm(n))
+}
+
+// Compose returns the composition of p and q, the permutation that applies q
+// first and then p, so that p.Compose(q)[v] = p[q[v]]. Both permutations must
+// have the same number of points.
+func (p Permutation) Compose(q Permutation) Permutation {
+	r := make(Permutation, len(q))
+	for v, w := range q {
+		r[v] = p[w]
+	}
+	return r
+}
+
+// Inverse returns the inverse of the permutation.
+func (p Permutation) Inverse() Permutation {
+	r := make(Permutation, len(p))
+	for v, w := range p {
+		r[w] = v
+	}
+	return r
+}
+
+// IsIdentity returns whether the permutation fixes every point.
+func (p Permutation) IsIdentity() bool {
+	for v, w := range p {
+		if v != w {
+			return false
+		}
+	}
+	return true
+}
+
+// Cycles returns the cycles of the permutation with more than one point. Each
+// cycle starts with its smallest point, and the cycles are sorted by their
+// first point.
+func (p Permutation) Cycles() [][]int {
+	var cycles [][]int
+	seen := make([]bool, len(p))
+	for v := range p {
+		if seen[v] || p[v] == v {
+			continue
+		}
+		var cycle []int
+		for w := v; !seen[w]; w = p[w] {
+			seen[w] = true
+			cycle = append(cycle, w)
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles
+}
+
+// Order returns the order of the permutation, the least common multiple of
+// the lengths of its cycles.
+func (p Permutation) Order() int {
+	order := 1
+	for _, cycle := range p.Cycles() {
+		a, b := order, len(cycle)
+		for b != 0 {
+			a, b = b, a%b
+		}
+		order = order / a * len(cycle)
+	}
+	return order
+}
+
+// String returns the permutation in cycle notation, omitting the fixed points,
+// such as (0 2 1)(3 4). The identity is written as ().
+func (p Permutation) String() string {
+	cycles := p.Cycles()
+	if len(cycles) == 0 {
+		return "()"
+	}
+	var b strings.Builder
+	for _, cycle := range cycles {
+		b.WriteString("(")
+		for i, v := range cycle {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(strconv.Itoa(v))
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// ParsePermutation returns the permutation of n points written in cycle
+// notation, such as (0 2 1)(3 4) or (0,2,1)(3,4). Points that do not appear are
+// fixed, and () is the identity. The cycles must be disjoint. If the string is
+// not a valid permutation of n points, an error is returned.
+func ParsePermutation(s string, n int) (Permutation, error) {
+	p := IdentityPermutation(n)
+	seen := make([]bool, n)
+	s = strings.TrimSpace(s)
+	for len(s) > 0 {
+		if s[0] != '(' {
+			return nil, InvalidPermutation
+		}
+		end := strings.IndexByte(s, ')')
+		if end == -1 {
+			return nil, InvalidPermutation
+		}
+		fields := strings.FieldsFunc(s[1:end], func(c rune) bool {
+			return c == ' ' || c == ','
+		})
+		cycle := make([]int, len(fields))
+		for i, field := range fields {
+			v, err := strconv.Atoi(field)
+			if err != nil || v < 0 || v >= n || seen[v] {
+				return nil, InvalidPermutation
+			}
+			seen[v] = true
+			cycle[i] = v
+		}
+		for i, v := range cycle {
+			p[v] = cycle[(i+1)%len(cycle)]
+		}
+		s = strings.TrimSpace(s[end+1:])
+	}
+	return p, nil
+}
+
+// Relabels an adjacency matrix with a permutation.
+func relabelMatrix(matrix AdjacencyMatrix, p Permutation) AdjacencyMatrix {
+	result := make([][]byte, len(matrix))
+	for i := range result {
+		result[i] = make([]byte, len(matrix))
+	}
+	for i, row := range matrix {
+		for j, w := range row {
+			result[p[i]][p[j]] = w
+		}
+	}
+	return result
+}
+
+// Relabels an adjacency list with a permutation.
+func relabelList(list AdjacencyList, p Permutation) AdjacencyList {
+	result := make([][]int, len(list))
+	for i, neighbours := range list {
+		relabelled := make([]int, len(neighbours))
+		for k, w := range neighbours {
+			relabelled[k] = p[w]
+		}
+		result[p[i]] = relabelled
+	}
+	return result
+}
+
+// Relabel returns the graph obtained by renaming every vertex v of a graph as
+// p[v]. The new graph is modelled by the same representations as the original
+// one. If p is not a permutation of the vertices, an error is returned.
+func Relabel(g *StaticGraph, p Permutation) (*StaticGraph, error) {
+	if _, err := NewPermutation(p); err != nil || len(p) != g.Order() {
+		return nil, InvalidPermutation
+	}
+	result := &StaticGraph{}
+	if g.matrix != nil {
+		result.matrix = relabelMatrix(g.matrix, p)
+	}
+	if g.list != nil {
+		result.list = relabelList(g.list, p)
+	}
+	return result, nil
+}
+
+// RelabelDigraph returns the digraph obtained by renaming every vertex v of a
+// digraph as p[v]. The new digraph is modelled by the same representations as
+// the original one. If p is not a permutation of the vertices, an error is
+// returned.
+func RelabelDigraph(d *StaticDigraph, p Permutation) (*StaticDigraph, error) {
+	g, err := Relabel(d.StaticGraph, p)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticDigraph{StaticGraph: g}, nil
+}
+
+// RandomRelabel returns a graph obtained by renaming the vertices of a graph
+// with a random permutation, together with the permutation. It is useful to
+// check that a function does not depend on the labels of the vertices.
+func RandomRelabel(g *StaticGraph, r *rand.Rand) (*StaticGraph, Permutation) {
+	p := RandomPermutation(g.Order(), r)
+	result, _ := Relabel(g, p)
+	return result, p
+}
+
+// RandomRelabelDigraph returns a digraph obtained by renaming the vertices of
+// a digraph with a random permutation, together with the permutation.
+func RandomRelabelDigraph(d *StaticDigraph, r *rand.Rand) (*StaticDigraph, Permutation) {
+	p := RandomPermutation(d.Order(), r)
+	result, _ := RelabelDigraph(d, p)
+	return result, p
+}
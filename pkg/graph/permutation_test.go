@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+)
+
+// TestPermutationOperations checks composition, inverses and orders.
+func TestPermutationOperations(t *testing.T) {
+	p := Permutation{1, 2, 0, 4, 3}
+	q := Permutation{0, 1, 2, 4, 3}
+	if r := p.Compose(q); !sliceutils.EqualIntSlice(r, []int{1, 2, 0, 3, 4}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2, 0, 3, 4}, []int(r))
+	}
+	if r := p.Compose(p.Inverse()); !r.IsIdentity() {
+		t.Errorf("Expected the identity, got %v", r)
+	}
+	if p.Order() != 6 || q.Order() != 2 || IdentityPermutation(3).Order() != 1 {
+		t.Errorf("Expected orders 6, 2 and 1, got %v, %v and %v", p.Order(), q.Order(),
+			IdentityPermutation(3).Order())
+	}
+	r := rand.New(rand.NewSource(38))
+	for k := 0; k < 20; k++ {
+		a, b, c := RandomPermutation(8, r), RandomPermutation(8, r), RandomPermutation(8, r)
+		if !sliceutils.EqualIntSlice(a.Compose(b).Compose(c), a.Compose(b.Compose(c))) {
+			t.Errorf("Composition of %v, %v and %v is not associative", a, b, c)
+		}
+		if !sliceutils.EqualIntSlice(a.Compose(b).Inverse(), b.Inverse().Compose(a.Inverse())) {
+			t.Errorf("Inverse of the composition of %v and %v is wrong", a, b)
+		}
+	}
+	if _, err := NewPermutation([]int{0, 2, 2}); err != InvalidPermutation {
+		t.Errorf("Expected %v, got %v", InvalidPermutation, err)
+	}
+}
+
+// TestCycleNotation checks that permutations are printed and parsed in cycle
+// notation.
+func TestCycleNotation(t *testing.T) {
+	cases := []struct {
+		p        Permutation
+		notation string
+	}{
+		{Permutation{1, 2, 0, 4, 3}, "(0 1 2)(3 4)"},
+		{Permutation{0, 1, 2}, "()"},
+		{Permutation{0, 3, 2, 1}, "(1 3)"},
+		{Permutation{}, "()"},
+	}
+	for _, c := range cases {
+		if s := c.p.String(); s != c.notation {
+			t.Errorf("Expected %v, got %v", c.notation, s)
+		}
+		p, err := ParsePermutation(c.notation, len(c.p))
+		if err != nil || !sliceutils.EqualIntSlice(p, c.p) {
+			t.Errorf("Expected %v, got %v", []int(c.p), []int(p))
+		}
+	}
+	p, err := ParsePermutation(" (2,0) ( 1 ) ", 4)
+	if err != nil || !sliceutils.EqualIntSlice(p, []int{2, 1, 0, 3}) {
+		t.Errorf("Expected %v, got %v", []int{2, 1, 0, 3}, []int(p))
+	}
+	for _, s := range []string{"(0 1)(1 2)", "(0 4)", "0 1", "(0 1", "(a)"} {
+		if _, err := ParsePermutation(s, 4); err != InvalidPermutation {
+			t.Errorf("Expected %v for %v, got %v", InvalidPermutation, s, err)
+		}
+	}
+}
+
+// TestRelabel checks that relabelling preserves the representation, and maps
+// edges and degrees accordingly.
+func TestRelabel(t *testing.T) {
+	path := NewFromList([][]int{{1}, {0, 2}, {1}})
+	p := Permutation{2, 0, 1}
+	relabelled, err := Relabel(path, p)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if _, err := relabelled.Matrix(); err != NilAdjacencyMatrix {
+		t.Errorf("Expected a graph modelled only by its list")
+	}
+	if degrees := relabelled.Degrees(); !sliceutils.EqualIntSlice(degrees, []int{2, 1, 1}) {
+		t.Errorf("Expected %v, got %v", []int{2, 1, 1}, degrees)
+	}
+	r := rand.New(rand.NewSource(38))
+	matrix := [][]byte{{0, 1, 1, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}, {1, 0, 0, 0}}
+	d := NewDigraphFromMatrix(matrix)
+	e, q := RandomRelabelDigraph(d, r)
+	b, _ := e.Matrix()
+	for i := range matrix {
+		for j := range matrix {
+			if matrix[i][j] != b[q[i]][q[j]] {
+				t.Errorf("Arc from %v to %v was not relabelled by %v", i, j, q)
+			}
+		}
+		if d.Outdegrees()[i] != e.Outdegrees()[q[i]] {
+			t.Errorf("Out-degree of %v was not relabelled by %v", i, q)
+		}
+	}
+	if _, err := Relabel(path, Permutation{0, 1}); err != InvalidPermutation {
+		t.Errorf("Expected %v, got %v", InvalidPermutation, err)
+	}
+}
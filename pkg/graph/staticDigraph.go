@@ -11,96 +11,197 @@ import (
 // either 1 or 0.
 type StaticDigraph struct {
 	*StaticGraph
-	indegreeSequence  []int
-	outdegreeSequence []int
+	degrees          []int
+	sortedDegrees    []int
+	indegrees        []int
+	outdegrees       []int
+	sortedIndegrees  []int
+	sortedOutdegrees []int
 }
 
 // NewDigraphFromMatrix initializes a digraph modelled by its adjacency matrix.
 func NewDigraphFromMatrix(matrix AdjacencyMatrix) *StaticDigraph {
 	return &StaticDigraph{
-		StaticGraph:       NewFromMatrix(matrix),
-		indegreeSequence:  nil,
-		outdegreeSequence: nil,
+		StaticGraph: NewFromMatrix(matrix),
 	}
 }
 
 // NewDigraphFromList initializes a digraph modelled by its adjacency list.
 func NewDigraphFromList(list AdjacencyList) *StaticDigraph {
 	return &StaticDigraph{
-		StaticGraph:       NewFromList(list),
-		indegreeSequence:  nil,
-		outdegreeSequence: nil,
+		StaticGraph: NewFromList(list),
 	}
 }
 
-// Computes in-degree and out-degree sequences of the digraph
-func (d *StaticDigraph) computeDegreeSequences() {
-	inSequence := make([]int, len(d.matrix))
-	outSequence := make([]int, len(d.matrix))
+// Computes the in-degree and out-degree of every vertex of the digraph.
+func (d *StaticDigraph) computeDegrees() {
+	indegrees := make([]int, d.Order())
+	outdegrees := make([]int, d.Order())
+	if d.matrix == nil {
+		for i, v := range d.list {
+			outdegrees[i] = len(v)
+			for _, j := range v {
+				indegrees[j]++
+			}
+		}
+	}
 	for i, v := range d.matrix {
 		for j, n := range v {
 			if n != 0 {
-				outSequence[i]++
-				inSequence[j]++
+				outdegrees[i]++
+				indegrees[j]++
 			}
 		}
 	}
-	d.indegreeSequence = inSequence
-	d.outdegreeSequence = outSequence
+	d.indegrees = indegrees
+	d.outdegrees = outdegrees
 }
 
-// DegreeSequence returns the degree sequence of the digraph.
-// The degree sequence of the digraph is the sum of the in-degree
-// sequence and the out-degree sequence.
+// Degrees returns the degree of every vertex of the digraph, indexed by
+// vertex, which is the sum of its in-degree and its out-degree.
+func (d *StaticDigraph) Degrees() []int {
+	if d.degrees == nil {
+		in, out := d.Indegrees(), d.Outdegrees()
+		degrees := make([]int, d.Order())
+		for i := range degrees {
+			degrees[i] = in[i] + out[i]
+		}
+		d.degrees = degrees
+	}
+	return d.degrees
+}
+
+// DegreeSequence returns the degree sequence of the digraph in
+// non-increasing order.
 func (d *StaticDigraph) DegreeSequence() []int {
-	if d.indegreeSequence == nil || d.outdegreeSequence == nil {
-		d.computeDegreeSequences()
+	if d.sortedDegrees == nil {
+		d.sortedDegrees = nonIncreasing(d.Degrees())
 	}
-	degreeSequence := make([]int, len(d.matrix))
-	for i := 0; i < len(d.matrix); i++ {
-		degreeSequence[i] = d.indegreeSequence[i] + d.outdegreeSequence[i]
+	return d.sortedDegrees
+}
+
+// Indegrees returns the in-degree of every vertex of the digraph, indexed by
+// vertex.
+func (d *StaticDigraph) Indegrees() []int {
+	if d.indegrees == nil {
+		d.computeDegrees()
 	}
-	return degreeSequence
+	return d.indegrees
+}
+
+// Outdegrees returns the out-degree of every vertex of the digraph, indexed by
+// vertex.
+func (d *StaticDigraph) Outdegrees() []int {
+	if d.outdegrees == nil {
+		d.computeDegrees()
+	}
+	return d.outdegrees
 }
 
 // IndegreeSequence returns the in-degree sequence of the digraph
 // in non-increasing order.
 func (d *StaticDigraph) IndegreeSequence() []int {
-	if d.indegreeSequence == nil {
-		d.computeDegreeSequences()
+	if d.sortedIndegrees == nil {
+		d.sortedIndegrees = nonIncreasing(d.Indegrees())
 	}
-	return d.indegreeSequence
+	return d.sortedIndegrees
 }
 
 // OutdegreeSequence returns the out-degree sequence of the
 // digraph in non-increasing order.
 func (d *StaticDigraph) OutdegreeSequence() []int {
-	if d.outdegreeSequence == nil {
-		d.computeDegreeSequences()
+	if d.sortedOutdegrees == nil {
+		d.sortedOutdegrees = nonIncreasing(d.Outdegrees())
 	}
-	return d.outdegreeSequence
+	return d.sortedOutdegrees
+}
+
+// MinDegree returns the minimum degree of the digraph, or 0 if it has no
+// vertices.
+func (d *StaticDigraph) MinDegree() int {
+	return minimum(d.DegreeSequence())
+}
+
+// MaxDegree returns the maximum degree of the digraph, or 0 if it has no
+// vertices.
+func (d *StaticDigraph) MaxDegree() int {
+	return maximum(d.DegreeSequence())
+}
+
+// MinIndegree returns the minimum in-degree of the digraph, or 0 if it has no
+// vertices.
+func (d *StaticDigraph) MinIndegree() int {
+	return minimum(d.IndegreeSequence())
+}
+
+// MaxIndegree returns the maximum in-degree of the digraph, or 0 if it has no
+// vertices.
+func (d *StaticDigraph) MaxIndegree() int {
+	return maximum(d.IndegreeSequence())
+}
+
+// MinOutdegree returns the minimum out-degree of the digraph, or 0 if it has
+// no vertices.
+func (d *StaticDigraph) MinOutdegree() int {
+	return minimum(d.OutdegreeSequence())
+}
+
+// MaxOutdegree returns the maximum out-degree of the digraph, or 0 if it has
+// no vertices.
+func (d *StaticDigraph) MaxOutdegree() int {
+	return maximum(d.OutdegreeSequence())
+}
+
+// IsRegular returns whether the digraph is regular, that is, whether every
+// vertex has the same in-degree and the same out-degree, and both are equal.
+func (d *StaticDigraph) IsRegular() bool {
+	return d.MinIndegree() == d.MaxIndegree() && d.MinOutdegree() == d.MaxOutdegree() &&
+		d.MinIndegree() == d.MinOutdegree()
+}
+
+// DegreeHistogram returns the number of vertices of each degree, where the
+// k-th entry counts the vertices of degree k, up to the maximum degree.
+func (d *StaticDigraph) DegreeHistogram() []int {
+	return histogram(d.Degrees())
+}
+
+// IndegreeHistogram returns the number of vertices of each in-degree, where
+// the k-th entry counts the vertices of in-degree k, up to the maximum
+// in-degree.
+func (d *StaticDigraph) IndegreeHistogram() []int {
+	return histogram(d.Indegrees())
+}
+
+// OutdegreeHistogram returns the number of vertices of each out-degree, where
+// the k-th entry counts the vertices of out-degree k, up to the maximum
+// out-degree.
+func (d *StaticDigraph) OutdegreeHistogram() []int {
+	return histogram(d.Outdegrees())
 }
 
 // Size returns the size (number of arcs) of a digraph.
 func (d *StaticDigraph) Size() int {
-	if d.indegreeSequence == nil || d.outdegreeSequence == nil {
+	if d.matrix == nil && (d.indegrees == nil || d.outdegrees == nil) {
+		d.computeDegrees()
+	}
+	if d.indegrees == nil || d.outdegrees == nil {
 		size := 0
-		inSequence := make([]int, len(d.matrix))
-		outSequence := make([]int, len(d.matrix))
+		indegrees := make([]int, len(d.matrix))
+		outdegrees := make([]int, len(d.matrix))
 		for i, v := range d.matrix {
 			for j, n := range v {
 				if n != 0 {
-					outSequence[i]++
-					inSequence[j]++
+					outdegrees[i]++
+					indegrees[j]++
 					size++
 				}
 			}
 		}
-		d.indegreeSequence = inSequence
-		d.outdegreeSequence = outSequence
+		d.indegrees = indegrees
+		d.outdegrees = outdegrees
 		return size
 	} else {
-		return (sliceutils.SumIntSlice(d.indegreeSequence) +
-			sliceutils.SumIntSlice(d.outdegreeSequence)) / 2
+		return (sliceutils.SumIntSlice(d.indegrees) +
+			sliceutils.SumIntSlice(d.outdegrees)) / 2
 	}
 }
@@ -48,8 +48,8 @@ func TestDigraphOrder(t *testing.T) {
 	}
 }
 
-// TestDigraphDegreeSequence tests that degree, in-degree, and
-// out-degree sequences are computed correctly for digraphs.
+// TestDigraphDegreeSequence tests that the degree, in-degree, and
+// out-degree of every vertex are computed correctly for digraphs.
 // First example digraph is C3*.
 // Second is from page 11 of article "3-transitive digraphs" by
 // Cesar Hernandez-Cruz, with loops added on vertices 4 and 5.
@@ -73,27 +73,27 @@ func TestDigraphDegreeSequence(t *testing.T) {
 	want2 := []int{2, 5, 3, 3, 7, 4}
 
 	// In-degree and out-degree sequences have not yet been computed.
-	if digraph1.indegreeSequence != nil {
+	if digraph1.indegrees != nil {
 		t.Errorf("In digraph 1, in-degree" +
 			"sequence was expected to be nil")
 	}
-	if digraph1.outdegreeSequence != nil {
+	if digraph1.outdegrees != nil {
 		t.Errorf("In digraph 1, out-degree" +
 			"sequence was expected to be nil")
 	}
-	if digraph2.indegreeSequence != nil {
+	if digraph2.indegrees != nil {
 		t.Errorf("In digraph 2, in-degree" +
 			"sequence was expected to be nil")
 	}
-	if digraph2.outdegreeSequence != nil {
+	if digraph2.outdegrees != nil {
 		t.Errorf("In digraph 2, out-degree" +
 			"sequence was expected to be nil")
 	}
 
-	got1 := digraph1.DegreeSequence()
-	got2 := digraph2.DegreeSequence()
+	got1 := digraph1.Degrees()
+	got2 := digraph2.Degrees()
 
-	// Testing DegreeSequence
+	// Testing Degrees
 	if !sliceutils.EqualIntSlice(want1, got1) {
 		t.Errorf("In graph 1, expected %v, got %v", want1,
 			got1)
@@ -106,10 +106,10 @@ func TestDigraphDegreeSequence(t *testing.T) {
 	want1 = []int{1, 2, 1}
 	want2 = []int{0, 2, 2, 1, 4, 3}
 
-	got1 = digraph1.IndegreeSequence()
-	got2 = digraph2.IndegreeSequence()
+	got1 = digraph1.Indegrees()
+	got2 = digraph2.Indegrees()
 
-	// Testing IndegreeSequence
+	// Testing Indegrees
 	if !sliceutils.EqualIntSlice(want1, got1) {
 		t.Errorf("In graph 1, expected %v, got %v", want1,
 			got1)
@@ -122,10 +122,10 @@ func TestDigraphDegreeSequence(t *testing.T) {
 	want1 = []int{1, 1, 2}
 	want2 = []int{2, 3, 1, 2, 3, 1}
 
-	got1 = digraph1.OutdegreeSequence()
-	got2 = digraph2.OutdegreeSequence()
+	got1 = digraph1.Outdegrees()
+	got2 = digraph2.Outdegrees()
 
-	// Testing IndegreeSequence
+	// Testing Outdegrees
 	if !sliceutils.EqualIntSlice(want1, got1) {
 		t.Errorf("In graph 1, expected %v, got %v", want1,
 			got1)
@@ -136,6 +136,57 @@ func TestDigraphDegreeSequence(t *testing.T) {
 	}
 }
 
+// TestDigraphDegreeStatistics checks the sorted degree sequences, the minimum
+// and maximum degrees, regularity and the histograms of the digraphs of
+// TestDigraphDegreeSequence and of a directed cycle.
+func TestDigraphDegreeStatistics(t *testing.T) {
+	digraph := NewDigraphFromMatrix([][]byte{
+		{0, 1, 0, 0, 1, 0},
+		{0, 0, 1, 1, 0, 1},
+		{0, 0, 0, 0, 1, 0},
+		{0, 1, 0, 0, 1, 0},
+		{0, 0, 1, 0, 1, 1},
+		{0, 0, 0, 0, 0, 1},
+	})
+	sequences := [][2][]int{
+		{digraph.DegreeSequence(), {7, 5, 4, 3, 3, 2}},
+		{digraph.IndegreeSequence(), {4, 3, 2, 2, 1, 0}},
+		{digraph.OutdegreeSequence(), {3, 3, 2, 2, 1, 1}},
+		{digraph.DegreeHistogram(), {0, 0, 1, 2, 1, 1, 0, 1}},
+		{digraph.IndegreeHistogram(), {1, 1, 2, 1, 1}},
+		{digraph.OutdegreeHistogram(), {0, 2, 2, 2}},
+	}
+	for _, s := range sequences {
+		if !sliceutils.EqualIntSlice(s[1], s[0]) {
+			t.Errorf("Expected %v, got %v", s[1], s[0])
+		}
+	}
+	statistics := [][2]int{
+		{digraph.MinDegree(), 2},
+		{digraph.MaxDegree(), 7},
+		{digraph.MinIndegree(), 0},
+		{digraph.MaxIndegree(), 4},
+		{digraph.MinOutdegree(), 1},
+		{digraph.MaxOutdegree(), 3},
+	}
+	for _, s := range statistics {
+		if s[0] != s[1] {
+			t.Errorf("Expected %v, got %v", s[1], s[0])
+		}
+	}
+	if digraph.IsRegular() {
+		t.Errorf("The digraph was not expected to be regular")
+	}
+	cycle := NewDigraphFromList([][]int{{1}, {2}, {0}})
+	if !cycle.IsRegular() || cycle.MaxDegree() != 2 {
+		t.Errorf("The directed cycle was expected to be 1-regular")
+	}
+	star := NewDigraphFromList([][]int{{1, 2}, {0}, {0}})
+	if star.IsRegular() {
+		t.Errorf("The digraph was not expected to be regular")
+	}
+}
+
 // TestSize tests that size of digraph is computed correctly. This test is
 // very similar to the one for MatrixGraphs.
 func TestDigraphSize(t *testing.T) {
@@ -152,10 +203,10 @@ func TestDigraphSize(t *testing.T) {
 		{0, 0, 0, 0, 1, 0, 1, 1, 0, 0},
 	}
 	petersen := NewDigraphFromMatrix(matrix)
-	if petersen.indegreeSequence != nil {
+	if petersen.indegrees != nil {
 		t.Errorf("The indegree sequence was expected to be nil")
 	}
-	if petersen.outdegreeSequence != nil {
+	if petersen.outdegrees != nil {
 		t.Errorf("The out-degree sequence was expected to be nil")
 	}
 	want := 10 * 3
@@ -169,10 +220,10 @@ func TestDigraphSize(t *testing.T) {
 	if want != got {
 		t.Errorf("Expected %d, got %d", want, got)
 	}
-	if petersen.indegreeSequence == nil {
+	if petersen.indegrees == nil {
 		t.Errorf("The indegree sequence was not expected to be nil")
 	}
-	if petersen.outdegreeSequence == nil {
+	if petersen.outdegrees == nil {
 		t.Errorf("The out-degree sequence was not expected to be nil")
 	}
 	wantD := []int{6, 6, 6, 6, 6, 6, 6, 6, 6, 6}
@@ -181,3 +232,64 @@ func TestDigraphSize(t *testing.T) {
 		t.Errorf("Expected %v, got %v", wantD, gotD)
 	}
 }
+
+// TestDigraphDegreeCaches checks that the degrees of a digraph do not depend
+// on whether the methods of the embedded graph were called before or after.
+func TestDigraphDegreeCaches(t *testing.T) {
+	matrix := [][]byte{{0, 1, 1}, {0, 0, 1}, {0, 0, 0}}
+	before := NewDigraphFromMatrix(matrix)
+	before.StaticGraph.Size()
+	before.StaticGraph.Degrees()
+	before.StaticGraph.DegreeSequence()
+	after := NewDigraphFromMatrix(matrix)
+	after.Degrees()
+	after.DegreeSequence()
+	sequences := [][2][]int{
+		{before.Degrees(), {2, 2, 2}},
+		{before.DegreeSequence(), {2, 2, 2}},
+		{after.StaticGraph.Degrees(), {2, 1, 0}},
+		{after.StaticGraph.DegreeSequence(), {2, 1, 0}},
+		{after.Degrees(), {2, 2, 2}},
+	}
+	for _, s := range sequences {
+		if !sliceutils.EqualIntSlice(s[1], s[0]) {
+			t.Errorf("Expected %v, got %v", s[1], s[0])
+		}
+	}
+}
+
+// TestListDigraphDegreeSequence checks the degrees, degree sequences and size
+// of a digraph modelled by its adjacency list. The digraph is C3* as in
+// TestDigraphDegreeSequence.
+func TestListDigraphDegreeSequence(t *testing.T) {
+	digraph := NewDigraphFromList([][]int{{1}, {2}, {0, 1}})
+	if digraph.Order() != 3 {
+		t.Errorf("Expected %v, got %v", 3, digraph.Order())
+	}
+	if digraph.Size() != 4 {
+		t.Errorf("Expected %v, got %v", 4, digraph.Size())
+	}
+	want := []int{2, 3, 3}
+	if got := digraph.Degrees(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	want = []int{1, 2, 1}
+	if got := digraph.Indegrees(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	want = []int{1, 1, 2}
+	if got := digraph.Outdegrees(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	want = []int{3, 3, 2}
+	if got := digraph.DegreeSequence(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	want = []int{2, 1, 1}
+	if got := digraph.IndegreeSequence(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got := digraph.OutdegreeSequence(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"sort"
+
 	"github.com/ciencias-graph-theory/graph-theory-tools/internal/set"
 	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
 )
@@ -14,9 +16,10 @@ import (
 // A static graph cannot be modified (neither vertices nor edges can be added
 // to it).
 type StaticGraph struct {
-	matrix         AdjacencyMatrix
-	list           AdjacencyList
-	degreeSequence []int
+	matrix        AdjacencyMatrix
+	list          AdjacencyList
+	degrees       []int
+	sortedDegrees []int
 }
 
 // NewGraphFromMatrix initializes a graph modelled by its adjacency matrix. This
@@ -31,9 +34,8 @@ func NewGraphFromMatrix(matrix AdjacencyMatrix) (*StaticGraph, error) {
 		}
 	}
 	return &StaticGraph{
-		matrix:         matrix,
-		list:           nil,
-		degreeSequence: nil,
+		matrix: matrix,
+		list:   nil,
 	}, nil
 }
 
@@ -42,9 +44,8 @@ func NewGraphFromMatrix(matrix AdjacencyMatrix) (*StaticGraph, error) {
 // argument is symmetric.
 func NewFromMatrix(adjacency AdjacencyMatrix) *StaticGraph {
 	return &StaticGraph{
-		matrix:         adjacency,
-		list:           nil,
-		degreeSequence: nil,
+		matrix: adjacency,
+		list:   nil,
 	}
 }
 
@@ -75,9 +76,8 @@ func NewGraphFromList(list AdjacencyList) (*StaticGraph, error) {
 		}
 	}
 	return &StaticGraph{
-		matrix:         nil,
-		list:           list,
-		degreeSequence: nil,
+		matrix: nil,
+		list:   list,
 	}, nil
 }
 
@@ -86,9 +86,8 @@ func NewGraphFromList(list AdjacencyList) (*StaticGraph, error) {
 // argument is valid.
 func NewFromList(list AdjacencyList) *StaticGraph {
 	return &StaticGraph{
-		matrix:         nil,
-		list:           list,
-		degreeSequence: nil,
+		matrix: nil,
+		list:   list,
 	}
 }
 
@@ -146,46 +145,128 @@ func listToMatrix(list AdjacencyList) (*AdjacencyMatrix, error) {
 
 // Order returns the number of vertices in the graph.
 func (g *StaticGraph) Order() int {
-	return len(g.matrix)
-	//TODO list case
+	if g.matrix != nil {
+		return len(g.matrix)
+	}
+	return len(g.list)
 }
 
-// DegreeSequence returns the degree sequence of the graph
-// in non-increasing order.
-func (g *StaticGraph) DegreeSequence() []int {
-	if g.degreeSequence != nil {
-		return g.degreeSequence
+// Degrees returns the degree of every vertex of the graph, indexed by vertex.
+func (g *StaticGraph) Degrees() []int {
+	if g.degrees != nil {
+		return g.degrees
+	} else if g.matrix == nil {
+		degrees := make([]int, len(g.list))
+		for i, v := range g.list {
+			degrees[i] = len(v)
+		}
+		g.degrees = degrees
+		return degrees
 	} else {
-		degreeSequence := make([]int, len(g.matrix))
+		degrees := make([]int, len(g.matrix))
 		for i, v := range g.matrix {
 			for _, n := range v {
 				if n == 1 {
-					degreeSequence[i] += 1
+					degrees[i] += 1
 				}
 			}
 		}
-		g.degreeSequence = degreeSequence
-		return degreeSequence
+		g.degrees = degrees
+		return degrees
+	}
+}
+
+// DegreeSequence returns the degree sequence of the graph
+// in non-increasing order.
+func (g *StaticGraph) DegreeSequence() []int {
+	if g.sortedDegrees == nil {
+		g.sortedDegrees = nonIncreasing(g.Degrees())
 	}
+	return g.sortedDegrees
+}
+
+// MinDegree returns the minimum degree of the graph, or 0 if it has no
+// vertices.
+func (g *StaticGraph) MinDegree() int {
+	return minimum(g.DegreeSequence())
+}
+
+// MaxDegree returns the maximum degree of the graph, or 0 if it has no
+// vertices.
+func (g *StaticGraph) MaxDegree() int {
+	return maximum(g.DegreeSequence())
+}
+
+// IsRegular returns whether every vertex of the graph has the same degree.
+func (g *StaticGraph) IsRegular() bool {
+	return g.MinDegree() == g.MaxDegree()
+}
+
+// DegreeHistogram returns the number of vertices of each degree, where the
+// k-th entry counts the vertices of degree k, up to the maximum degree.
+func (g *StaticGraph) DegreeHistogram() []int {
+	return histogram(g.Degrees())
+}
+
+// Returns a copy of a sequence in non-increasing order.
+func nonIncreasing(s []int) []int {
+	sorted := make([]int, len(s))
+	copy(sorted, s)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	return sorted
+}
+
+// Returns the last element of a sequence in non-increasing order, or 0 if it
+// is empty.
+func minimum(sorted []int) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[len(sorted)-1]
+}
+
+// Returns the first element of a sequence in non-increasing order, or 0 if it
+// is empty.
+func maximum(sorted []int) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[0]
+}
+
+// Returns the number of occurrences of each value of a sequence of
+// non-negative integers, up to its maximum.
+func histogram(s []int) []int {
+	if len(s) == 0 {
+		return []int{}
+	}
+	h := make([]int, maximum(nonIncreasing(s))+1)
+	for _, x := range s {
+		h[x]++
+	}
+	return h
 }
 
 // Size returns the size (number of edges) of a graph.
 func (g *StaticGraph) Size() int {
-	if g.degreeSequence != nil {
-		return sliceutils.SumIntSlice(g.degreeSequence) / 2
+	if g.degrees != nil {
+		return sliceutils.SumIntSlice(g.degrees) / 2
+	}
+	if g.matrix == nil {
+		return sliceutils.SumIntSlice(g.Degrees()) / 2
 	}
 	size := 0
-	degreeSequence := make([]int, len(g.matrix))
+	degrees := make([]int, len(g.matrix))
 	for i, v := range g.matrix {
 		for j := 0; j < i+1; j++ {
 			if v[j] != 0 {
-				degreeSequence[i]++
-				degreeSequence[j]++
+				degrees[i]++
+				degrees[j]++
 				size++
 			}
 		}
 	}
-	g.degreeSequence = degreeSequence
+	g.degrees = degrees
 	return size
 }
 
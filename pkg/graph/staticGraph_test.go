@@ -224,15 +224,15 @@ func TestDegreeSequence(t *testing.T) {
 		{0, 0, 0, 0, 1, 0, 1, 1, 0, 0},
 	}
 	petersen := NewFromMatrix(adjacency)
-	if petersen.degreeSequence != nil {
-		t.Errorf("The degree sequence was expected to be nil")
+	if petersen.degrees != nil {
+		t.Errorf("The degrees were expected to be nil")
 	}
 	want := []int{3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
 	got := petersen.DegreeSequence()
 	if !sliceutils.EqualIntSlice(want, got) {
 		t.Errorf("Expected %v, got %v", want, got)
 	}
-	got = petersen.degreeSequence
+	got = petersen.degrees
 	if !sliceutils.EqualIntSlice(want, got) {
 		t.Errorf("Expected %v, got %v", want, got)
 	}
@@ -253,8 +253,8 @@ func TestSize(t *testing.T) {
 		{0, 0, 0, 0, 1, 0, 1, 1, 0, 0},
 	}
 	petersen := NewFromMatrix(adjacency)
-	if petersen.degreeSequence != nil {
-		t.Errorf("The degree sequence was expected to be nil")
+	if petersen.degrees != nil {
+		t.Errorf("The degrees were expected to be nil")
 	}
 	want := (10 * 3) / 2
 	got := petersen.Size()
@@ -267,8 +267,8 @@ func TestSize(t *testing.T) {
 	if want != got {
 		t.Errorf("Expected %d, got %d", want, got)
 	}
-	if petersen.degreeSequence == nil {
-		t.Errorf("The degree sequence was not expected to be nil")
+	if petersen.degrees == nil {
+		t.Errorf("The degrees were not expected to be nil")
 	}
 	wantD := []int{3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
 	gotD := petersen.DegreeSequence()
@@ -312,3 +312,95 @@ func TestNeighboursSet(t *testing.T) {
 		}
 	}
 }
+
+// TestMatrixOf checks that MatrixOf returns the adjacency matrix of a graph
+// regardless of its representation.
+func TestMatrixOf(t *testing.T) {
+	m := [][]byte{
+		{0, 1, 1, 0},
+		{1, 0, 1, 1},
+		{1, 1, 0, 1},
+		{0, 1, 1, 0},
+	}
+	l := [][]int{
+		{1, 2},
+		{0, 2, 3},
+		{0, 1, 3},
+		{1, 2},
+	}
+	if got := MatrixOf(NewFromMatrix(m)); !reflect.DeepEqual(m, got) {
+		t.Errorf("Expected %v, got %v", m, got)
+	}
+	if got := MatrixOf(NewFromList(l)); !reflect.DeepEqual(m, got) {
+		t.Errorf("Expected %v, got %v", m, got)
+	}
+	if got := MatrixOf(&StaticGraph{}); got != nil {
+		t.Errorf("Expected %v, got %v", nil, got)
+	}
+}
+
+// TestListOrderAndDegrees checks that order, degree sequence and size are
+// computed for a graph modelled by its adjacency list.
+func TestListOrderAndDegrees(t *testing.T) {
+	l := [][]int{
+		{1, 2},
+		{0, 2, 3},
+		{0, 1, 3},
+		{1, 2},
+		{},
+	}
+	g := NewFromList(l)
+	if g.Order() != 5 {
+		t.Errorf("Expected %v, got %v", 5, g.Order())
+	}
+	want := []int{2, 3, 3, 2, 0}
+	if got := g.Degrees(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	want = []int{3, 3, 2, 2, 0}
+	if got := g.DegreeSequence(); !sliceutils.EqualIntSlice(want, got) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if g.Size() != 5 {
+		t.Errorf("Expected %v, got %v", 5, g.Size())
+	}
+	if g = NewFromList(l); g.Size() != 5 {
+		t.Errorf("Expected %v, got %v", 5, g.Size())
+	}
+}
+
+// TestDegreeStatistics checks the sorted degree sequence, the minimum and
+// maximum degrees, regularity and the degree histogram of some graphs.
+func TestDegreeStatistics(t *testing.T) {
+	tests := []struct {
+		g                *StaticGraph
+		sequence         []int
+		minimum, maximum int
+		regular          bool
+		histogram        []int
+	}{
+		{NewFromList([][]int{{1, 2, 3}, {0}, {0}, {0}}), []int{3, 1, 1, 1}, 1, 3, false,
+			[]int{0, 3, 0, 1}},
+		{NewFromMatrix([][]byte{{0, 1, 1}, {1, 0, 1}, {1, 1, 0}}), []int{2, 2, 2}, 2, 2, true,
+			[]int{0, 0, 3}},
+		{NewFromList([][]int{{}, {2}, {1}}), []int{1, 1, 0}, 0, 1, false, []int{1, 2}},
+		{NewFromList([][]int{}), []int{}, 0, 0, true, []int{}},
+	}
+	for _, test := range tests {
+		if got := test.g.DegreeSequence(); !sliceutils.EqualIntSlice(test.sequence, got) {
+			t.Errorf("Expected %v, got %v", test.sequence, got)
+		}
+		if got := test.g.MinDegree(); got != test.minimum {
+			t.Errorf("Expected %v, got %v", test.minimum, got)
+		}
+		if got := test.g.MaxDegree(); got != test.maximum {
+			t.Errorf("Expected %v, got %v", test.maximum, got)
+		}
+		if got := test.g.IsRegular(); got != test.regular {
+			t.Errorf("Expected %v, got %v", test.regular, got)
+		}
+		if got := test.g.DegreeHistogram(); !sliceutils.EqualIntSlice(test.histogram, got) {
+			t.Errorf("Expected %v, got %v", test.histogram, got)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package invariants
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/algorithms"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// The invariants consider graphs as simple: directions, loops and multiple
+// edges are ignored. The invariants that are NP-hard to compute use exact
+// exponential searches, so they are intended for small graphs.
+
+// Returns the adjacency relation of the simple graph underlying a graph.
+func adjacency(g Graph) [][]bool {
+	a := graph.MatrixOf(g)
+	adj := make([][]bool, len(a))
+	for i := range a {
+		adj[i] = make([]bool, len(a))
+		for j := range a {
+			adj[i][j] = i != j && (a[i][j] != 0 || a[j][i] != 0)
+		}
+	}
+	return adj
+}
+
+// Returns the degree of every vertex in an adjacency relation.
+func degrees(adj [][]bool) []int {
+	d := make([]int, len(adj))
+	for i, row := range adj {
+		for _, b := range row {
+			if b {
+				d[i]++
+			}
+		}
+	}
+	return d
+}
+
+// Triangles returns the number of triangles of a graph.
+func Triangles(g Graph) int {
+	adj := adjacency(g)
+	count := 0
+	for i := range adj {
+		for j := i + 1; j < len(adj); j++ {
+			if !adj[i][j] {
+				continue
+			}
+			for k := j + 1; k < len(adj); k++ {
+				if adj[i][k] && adj[j][k] {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// ClusteringCoefficient returns the average clustering coefficient of a
+// graph. The clustering coefficient of a vertex is the fraction of pairs of
+// its neighbours that are adjacent, and 0 if it has less than two neighbours.
+func ClusteringCoefficient(g Graph) float64 {
+	adj := adjacency(g)
+	if len(adj) == 0 {
+		return 0
+	}
+	total := 0.0
+	for v := range adj {
+		var neighbours []int
+		for w, b := range adj[v] {
+			if b {
+				neighbours = append(neighbours, w)
+			}
+		}
+		d := len(neighbours)
+		if d < 2 {
+			continue
+		}
+		links := 0
+		for i, u := range neighbours {
+			for _, w := range neighbours[i+1:] {
+				if adj[u][w] {
+					links++
+				}
+			}
+		}
+		total += float64(2*links) / float64(d*(d-1))
+	}
+	return total / float64(len(adj))
+}
+
+// Returns the order of a largest clique in an adjacency relation, using the
+// branch and bound algorithm of Carraghan and Pardalos.
+func maximumClique(adj [][]bool) int {
+	best := 0
+	var expand func(size int, candidates []int)
+	expand = func(size int, candidates []int) {
+		if len(candidates) == 0 {
+			if size > best {
+				best = size
+			}
+			return
+		}
+		for i, v := range candidates {
+			if size+len(candidates)-i <= best {
+				return
+			}
+			var next []int
+			for _, w := range candidates[i+1:] {
+				if adj[v][w] {
+					next = append(next, w)
+				}
+			}
+			expand(size+1, next)
+		}
+	}
+	all := make([]int, len(adj))
+	for v := range all {
+		all[v] = v
+	}
+	expand(0, all)
+	return best
+}
+
+// CliqueNumber returns the order of a largest clique of a graph.
+func CliqueNumber(g Graph) int {
+	return maximumClique(adjacency(g))
+}
+
+// IndependenceNumber returns the order of a largest independent set of a
+// graph, the clique number of its complement.
+func IndependenceNumber(g Graph) int {
+	adj := adjacency(g)
+	for i := range adj {
+		for j := range adj {
+			adj[i][j] = i != j && !adj[i][j]
+		}
+	}
+	return maximumClique(adj)
+}
+
+// Returns whether the vertices of an adjacency relation can be properly
+// coloured with k colours, by backtracking over the vertices in decreasing
+// order of degree. A vertex never receives a colour greater than the number of
+// colours used so far, to avoid exploring permutations of the colours.
+func colourable(adj [][]bool, k int) bool {
+	d := degrees(adj)
+	order := make([]int, len(adj))
+	for v := range order {
+		order[v] = v
+	}
+	for i := range order {
+		for j := i + 1; j < len(order); j++ {
+			if d[order[j]] > d[order[i]] {
+				order[i], order[j] = order[j], order[i]
+			}
+		}
+	}
+	colour := make([]int, len(adj))
+	for v := range colour {
+		colour[v] = -1
+	}
+	var extend func(i, used int) bool
+	extend = func(i, used int) bool {
+		if i == len(order) {
+			return true
+		}
+		v := order[i]
+		for c := 0; c < k && c <= used; c++ {
+			proper := true
+			for w, b := range adj[v] {
+				if b && colour[w] == c {
+					proper = false
+					break
+				}
+			}
+			if !proper {
+				continue
+			}
+			colour[v] = c
+			next := used
+			if c == used {
+				next++
+			}
+			if extend(i+1, next) {
+				return true
+			}
+			colour[v] = -1
+		}
+		return false
+	}
+	return extend(0, 0)
+}
+
+// ChromaticNumber returns the least number of colours needed to colour the
+// vertices of a graph so that adjacent vertices have different colours.
+func ChromaticNumber(g Graph) int {
+	adj := adjacency(g)
+	for k := maximumClique(adj); ; k++ {
+		if colourable(adj, k) {
+			return k
+		}
+	}
+}
+
+// ChromaticIndex returns the least number of colours needed to colour the
+// edges of a graph so that edges sharing an endpoint have different colours.
+func ChromaticIndex(g Graph) int {
+	return algorithms.ChromaticIndex(graph.NewFromMatrix(simpleMatrix(g)))
+}
+
+// DominationNumber returns the order of a smallest dominating set of a graph,
+// a set of vertices such that every vertex is in the set or adjacent to it.
+func DominationNumber(g Graph) int {
+	return len(algorithms.MinimumDominatingSet(graph.NewFromMatrix(simpleMatrix(g))))
+}
+
+// Degeneracy returns the least k such that every subgraph of a graph has a
+// vertex of degree at most k, computed by repeatedly deleting a vertex of
+// minimum degree.
+func Degeneracy(g Graph) int {
+	adj := adjacency(g)
+	d := degrees(adj)
+	deleted := make([]bool, len(adj))
+	k := 0
+	for range adj {
+		v := -1
+		for w := range adj {
+			if !deleted[w] && (v == -1 || d[w] < d[v]) {
+				v = w
+			}
+		}
+		if d[v] > k {
+			k = d[v]
+		}
+		deleted[v] = true
+		for w, b := range adj[v] {
+			if b {
+				d[w]--
+			}
+		}
+	}
+	return k
+}
+
+// Components returns the number of connected components of a graph.
+func Components(g Graph) int {
+	adj := adjacency(g)
+	seen := make([]bool, len(adj))
+	count := 0
+	for s := range adj {
+		if seen[s] {
+			continue
+		}
+		count++
+		seen[s] = true
+		stack := []int{s}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for w, b := range adj[v] {
+				if b && !seen[w] {
+					seen[w] = true
+					stack = append(stack, w)
+				}
+			}
+		}
+	}
+	return count
+}
+
+// Girth returns the length of a shortest cycle of a graph, or 0 if the graph
+// is a forest. A breadth-first search is done from every vertex, and every
+// edge joining two vertices already reached closes a cycle through the root
+// of length at most the sum of their distances plus one.
+func Girth(g Graph) int {
+	adj := adjacency(g)
+	girth := 0
+	for s := range adj {
+		distance := make([]int, len(adj))
+		parent := make([]int, len(adj))
+		for v := range distance {
+			distance[v] = -1
+		}
+		distance[s], parent[s] = 0, -1
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for w, b := range adj[v] {
+				if !b || w == parent[v] {
+					continue
+				}
+				if distance[w] == -1 {
+					distance[w], parent[w] = distance[v]+1, v
+					queue = append(queue, w)
+				} else if length := distance[v] + distance[w] + 1; girth == 0 || length < girth {
+					girth = length
+				}
+			}
+		}
+	}
+	return girth
+}
+
+// Circumference returns the length of a longest cycle of a graph, or 0 if the
+// graph is a forest. Every cycle is found by extending paths from its smallest
+// vertex.
+func Circumference(g Graph) int {
+	adj := adjacency(g)
+	longest := 0
+	onPath := make([]bool, len(adj))
+	var extend func(s, v, length int)
+	extend = func(s, v, length int) {
+		for w, b := range adj[v] {
+			if !b {
+				continue
+			}
+			if w == s && length >= 3 && length > longest {
+				longest = length
+			} else if w > s && !onPath[w] {
+				onPath[w] = true
+				extend(s, w, length+1)
+				onPath[w] = false
+			}
+		}
+	}
+	for s := range adj {
+		// The cycles whose smallest vertex is s have at most n - s vertices.
+		if longest >= len(adj)-s {
+			break
+		}
+		onPath[s] = true
+		extend(s, s, 1)
+		onPath[s] = false
+	}
+	return longest
+}
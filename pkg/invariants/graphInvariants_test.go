@@ -0,0 +1,144 @@
+package invariants
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/operations"
+)
+
+// The expected values of the invariants of a graph.
+type expectedInvariants struct {
+	name                                 string
+	g                                    Graph
+	triangles                            int
+	clustering                           float64
+	independence, clique, chromatic      int
+	domination, degeneracy, components   int
+	girth, circumference, chromaticIndex int
+}
+
+// TestKnownInvariants checks the invariants of some well-known graphs.
+func TestKnownInvariants(t *testing.T) {
+	wheel, _ := generators.WheelMatrixGraph(5)
+	cases := []expectedInvariants{
+		{"Petersen graph", generators.PetersenMatrixGraph(), 0, 0, 4, 2, 3, 3, 3, 1, 5, 9, 4},
+		{"K4", generators.CompleteListGraph(4), 4, 1, 1, 4, 4, 1, 3, 1, 3, 4, 3},
+		{"C5", generators.MatrixCycle(5), 0, 0, 2, 2, 3, 2, 2, 1, 5, 5, 3},
+		{"P4", generators.ListPath(4), 0, 0, 2, 2, 2, 2, 1, 1, 0, 0, 2},
+		{"K(3,3)", generators.CompleteBipartiteMatrixGraph(3, 3), 0, 0, 3, 2, 2, 2, 3, 1, 4, 6, 3},
+		{"W5", wheel, 5, (5*2.0/3 + 0.5) / 6, 2, 3, 4, 1, 3, 1, 3, 6, 5},
+		{"2K2 + K1", operations.DisjointUnion(generators.CompleteMatrixGraph(2),
+			operations.DisjointUnion(generators.CompleteMatrixGraph(2), generators.CompleteMatrixGraph(1))),
+			0, 0, 3, 2, 2, 3, 1, 3, 0, 0, 1},
+		{"empty graph", graph.NewFromMatrix([][]byte{}), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	}
+	for _, c := range cases {
+		got := []int{Triangles(c.g), IndependenceNumber(c.g), CliqueNumber(c.g),
+			ChromaticNumber(c.g), DominationNumber(c.g), Degeneracy(c.g), Components(c.g),
+			Girth(c.g), Circumference(c.g), ChromaticIndex(c.g)}
+		expected := []int{c.triangles, c.independence, c.clique, c.chromatic, c.domination,
+			c.degeneracy, c.components, c.girth, c.circumference, c.chromaticIndex}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Errorf("Expected invariants %v of the %v, got %v", expected, c.name, got)
+				break
+			}
+		}
+		if clustering := ClusteringCoefficient(c.g); math.Abs(clustering-c.clustering) > 1e-9 {
+			t.Errorf("Expected clustering coefficient %v of the %v, got %v", c.clustering,
+				c.name, clustering)
+		}
+	}
+}
+
+// Returns the number of edges of a graph with both endpoints in a set of
+// vertices given as a bit mask, and the set of vertices dominated by it.
+func inspectSubset(adj [][]bool, mask int) (int, int) {
+	edges, dominated := 0, mask
+	for v := range adj {
+		if mask&(1<<v) == 0 {
+			continue
+		}
+		for w, b := range adj[v] {
+			if b {
+				dominated |= 1 << w
+				if w > v && mask&(1<<w) != 0 {
+					edges++
+				}
+			}
+		}
+	}
+	return edges, dominated
+}
+
+// TestInvariantsBruteForce compares the independence, clique, domination and
+// chromatic numbers of random graphs against brute force searches, and checks
+// that the invariants do not depend on the labels of the vertices.
+func TestInvariantsBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(39))
+	for k := 0; k < 40; k++ {
+		n := 1 + r.Intn(8)
+		g, _ := generators.GnpRandomGraph(n, r.Float64(), r)
+		adj := adjacency(g)
+		independence, clique, domination := 0, 0, n
+		for mask := 0; mask < 1<<n; mask++ {
+			size := 0
+			for v := 0; v < n; v++ {
+				size += (mask >> v) & 1
+			}
+			edges, dominated := inspectSubset(adj, mask)
+			if edges == 0 && size > independence {
+				independence = size
+			}
+			if edges == size*(size-1)/2 && size > clique {
+				clique = size
+			}
+			if dominated == 1<<n-1 && size < domination {
+				domination = size
+			}
+		}
+		chromatic := n
+		colours := make([]int, n)
+		for c := 1; c < chromatic; c++ {
+			total := int(math.Pow(float64(c), float64(n)))
+			for code := 0; code < total && chromatic == n; code++ {
+				x := code
+				for v := range colours {
+					colours[v], x = x%c, x/c
+				}
+				proper := true
+				for v := range adj {
+					for w, b := range adj[v] {
+						if b && colours[v] == colours[w] {
+							proper = false
+						}
+					}
+				}
+				if proper {
+					chromatic = c
+				}
+			}
+		}
+		got := []int{IndependenceNumber(g), CliqueNumber(g), DominationNumber(g), ChromaticNumber(g)}
+		expected := []int{independence, clique, domination, chromatic}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Errorf("Expected independence, clique, domination and chromatic numbers %v, got %v",
+					expected, got)
+				break
+			}
+		}
+		h, _ := graph.RandomRelabel(g, r)
+		for _, name := range Names() {
+			x, _ := Compute(name, g)
+			y, _ := Compute(name, h)
+			if x != y && !(math.IsNaN(x) && math.IsNaN(y)) && math.Abs(x-y) > 1e-9 {
+				t.Errorf("Invariant %v changed from %v to %v after relabelling", name, x, y)
+			}
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package invariants
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+var (
+	UnknownInvariant   = graph.GraphError("No invariant is registered with the given name")
+	DuplicateInvariant = graph.GraphError("An invariant is already registered with the given name")
+)
@@ -0,0 +1,434 @@
+package invariants
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// The polynomials are computed with recurrences that reduce a graph to
+// smaller ones, such as deletion and contraction of an edge. Isomorphic graphs
+// appear many times during the recursion, so the polynomial of every graph
+// found is stored under its canonical certificate. A polynomial in x is a
+// slice of coefficients, where the coefficient of x^i is at position i, and a
+// polynomial in x and y is a matrix, where the coefficient of x^i y^j is at
+// position [i][j].
+
+// Returns a polynomial with a single term c x^k.
+func monomial(c int64, k int) []*big.Int {
+	p := make([]*big.Int, k+1)
+	for i := range p {
+		p[i] = new(big.Int)
+	}
+	p[k].SetInt64(c)
+	return p
+}
+
+// Returns the sum of two polynomials, where q is multiplied by sign and by
+// x^shift.
+func addPolynomials(p, q []*big.Int, sign int64, shift int) []*big.Int {
+	n := len(p)
+	if len(q)+shift > n {
+		n = len(q) + shift
+	}
+	r := make([]*big.Int, n)
+	for i := range r {
+		r[i] = new(big.Int)
+		if i < len(p) {
+			r[i].Set(p[i])
+		}
+	}
+	s := big.NewInt(sign)
+	for i, c := range q {
+		r[i+shift].Add(r[i+shift], new(big.Int).Mul(s, c))
+	}
+	return trimPolynomial(r)
+}
+
+// Removes the leading zero coefficients of a polynomial.
+func trimPolynomial(p []*big.Int) []*big.Int {
+	for len(p) > 1 && p[len(p)-1].Sign() == 0 {
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// Returns the certificate of a matrix, used to memoise polynomials.
+func certificate(a graph.AdjacencyMatrix) string {
+	return isomorphism.Certificate(graph.NewFromMatrix(a))
+}
+
+// Returns the adjacency matrix of the simple graph underlying a graph.
+func simpleMatrix(g Graph) graph.AdjacencyMatrix {
+	adj := adjacency(g)
+	a := make([][]byte, len(adj))
+	for i := range adj {
+		a[i] = make([]byte, len(adj))
+		for j, b := range adj[i] {
+			if b {
+				a[i][j] = 1
+			}
+		}
+	}
+	return a
+}
+
+// Returns the first edge (u, v) of a matrix with u < v, or (-1, -1) if there
+// are none.
+func firstEdge(a graph.AdjacencyMatrix) (int, int) {
+	for i := range a {
+		for j := i + 1; j < len(a); j++ {
+			if a[i][j] != 0 {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
+
+// Returns a copy of a matrix without some vertices.
+func deleteVertices(a graph.AdjacencyMatrix, vertices ...int) graph.AdjacencyMatrix {
+	deleted := make([]bool, len(a))
+	for _, v := range vertices {
+		deleted[v] = true
+	}
+	var b [][]byte
+	for i := range a {
+		if deleted[i] {
+			continue
+		}
+		var row []byte
+		for j := range a {
+			if !deleted[j] {
+				row = append(row, a[i][j])
+			}
+		}
+		b = append(b, row)
+	}
+	return b
+}
+
+// Returns a copy of a matrix with the arc between u and v, in both directions,
+// decreased by one.
+func deleteOneEdge(a graph.AdjacencyMatrix, u, v int) graph.AdjacencyMatrix {
+	b := make([][]byte, len(a))
+	for i := range a {
+		b[i] = append([]byte(nil), a[i]...)
+	}
+	b[u][v]--
+	b[v][u]--
+	return b
+}
+
+// Returns a copy of a multigraph matrix where one of the edges between u and v
+// is contracted into u. Loops are counted once on the diagonal, so the other
+// edges between u and v become loops. If simple is true, multiple edges and
+// loops are discarded instead.
+func contractEdge(a graph.AdjacencyMatrix, u, v int, simple bool) graph.AdjacencyMatrix {
+	b := deleteOneEdge(a, u, v)
+	b[u][u] += b[u][v] + b[v][v]
+	b[u][v], b[v][u], b[v][v] = 0, 0, 0
+	for w := range b {
+		if w != u && w != v {
+			b[u][w] += b[v][w]
+			b[w][u] += b[w][v]
+		}
+	}
+	if simple {
+		for w := range b {
+			if b[u][w] > 1 {
+				b[u][w], b[w][u] = 1, 1
+			}
+		}
+		b[u][u] = 0
+	}
+	return deleteVertices(b, v)
+}
+
+// ChromaticPolynomial returns the chromatic polynomial of a graph, whose value
+// at a positive integer k is the number of proper colourings of its vertices
+// with k colours. It is computed by deletion and contraction: P(G) =
+// P(G - e) - P(G / e), where an edgeless graph of order n has polynomial x^n
+// and a complete graph has polynomial x(x - 1)...(x - n + 1).
+func ChromaticPolynomial(g Graph) []*big.Int {
+	memo := make(map[string][]*big.Int)
+	var chromatic func(a graph.AdjacencyMatrix) []*big.Int
+	chromatic = func(a graph.AdjacencyMatrix) []*big.Int {
+		n := len(a)
+		u, v := firstEdge(a)
+		if u == -1 {
+			return monomial(1, n)
+		}
+		edges := 0
+		for i := range a {
+			for j := i + 1; j < n; j++ {
+				edges += int(a[i][j])
+			}
+		}
+		if edges == n*(n-1)/2 {
+			p := monomial(1, 0)
+			for k := 0; k < n; k++ {
+				p = addPolynomials(addPolynomials(monomial(0, 0), p, 1, 1), p, int64(-k), 0)
+			}
+			return p
+		}
+		key := certificate(a)
+		if p, ok := memo[key]; ok {
+			return p
+		}
+		p := addPolynomials(chromatic(deleteOneEdge(a, u, v)), chromatic(contractEdge(a, u, v, true)), -1, 0)
+		memo[key] = p
+		return p
+	}
+	return chromatic(simpleMatrix(g))
+}
+
+// IndependencePolynomial returns the independence polynomial of a graph, where
+// the coefficient of x^k is the number of independent sets of k vertices. It
+// is computed with the recurrence I(G) = I(G - v) + x I(G - N[v]).
+func IndependencePolynomial(g Graph) []*big.Int {
+	memo := make(map[string][]*big.Int)
+	var independence func(a graph.AdjacencyMatrix) []*big.Int
+	independence = func(a graph.AdjacencyMatrix) []*big.Int {
+		if len(a) == 0 {
+			return monomial(1, 0)
+		}
+		key := certificate(a)
+		if p, ok := memo[key]; ok {
+			return p
+		}
+		// Branching on a vertex of maximum degree removes the most vertices.
+		v, degree := 0, -1
+		for i := range a {
+			if d := rowDegree(a[i]); d > degree {
+				v, degree = i, d
+			}
+		}
+		closed := []int{v}
+		for w := range a {
+			if a[v][w] != 0 {
+				closed = append(closed, w)
+			}
+		}
+		p := addPolynomials(independence(deleteVertices(a, v)), independence(deleteVertices(a, closed...)), 1, 1)
+		memo[key] = p
+		return p
+	}
+	return independence(simpleMatrix(g))
+}
+
+// Returns the number of nonzero entries of a row.
+func rowDegree(row []byte) int {
+	d := 0
+	for _, w := range row {
+		if w != 0 {
+			d++
+		}
+	}
+	return d
+}
+
+// MatchingPolynomial returns the matching polynomial of a graph of order n,
+// the sum of (-1)^k m_k x^(n-2k), where m_k is the number of matchings with k
+// edges. It is computed with the recurrence M(G) = M(G - e) - M(G - u - v) for
+// an edge e = uv. For forests, it equals the characteristic polynomial.
+func MatchingPolynomial(g Graph) []*big.Int {
+	memo := make(map[string][]*big.Int)
+	var matching func(a graph.AdjacencyMatrix) []*big.Int
+	matching = func(a graph.AdjacencyMatrix) []*big.Int {
+		u, v := firstEdge(a)
+		if u == -1 {
+			return monomial(1, len(a))
+		}
+		key := certificate(a)
+		if p, ok := memo[key]; ok {
+			return p
+		}
+		p := addPolynomials(matching(deleteOneEdge(a, u, v)), matching(deleteVertices(a, u, v)), -1, 0)
+		memo[key] = p
+		return p
+	}
+	return matching(simpleMatrix(g))
+}
+
+// Returns the sum of two polynomials in two variables, where q is multiplied
+// by x^shiftX y^shiftY.
+func addBivariate(p, q [][]*big.Int, shiftX, shiftY int) [][]*big.Int {
+	rows, columns := 0, 0
+	for _, s := range [][][]*big.Int{p, q} {
+		for i, row := range s {
+			if i+1 > rows {
+				rows = i + 1
+			}
+			if len(row) > columns {
+				columns = len(row)
+			}
+		}
+	}
+	if len(q) > 0 {
+		rows += shiftX
+		columns += shiftY
+	}
+	r := make([][]*big.Int, rows)
+	for i := range r {
+		r[i] = make([]*big.Int, columns)
+		for j := range r[i] {
+			r[i][j] = new(big.Int)
+		}
+	}
+	for i, row := range p {
+		for j, c := range row {
+			r[i][j].Add(r[i][j], c)
+		}
+	}
+	for i, row := range q {
+		for j, c := range row {
+			r[i+shiftX][j+shiftY].Add(r[i+shiftX][j+shiftY], c)
+		}
+	}
+	return r
+}
+
+// Returns whether u and v are connected in a matrix without one of the edges
+// between them.
+func connectedWithoutEdge(a graph.AdjacencyMatrix, u, v int) bool {
+	b := deleteOneEdge(a, u, v)
+	seen := make([]bool, len(b))
+	seen[u] = true
+	stack := []int{u}
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for y := range b {
+			if b[x][y] != 0 && !seen[y] {
+				seen[y] = true
+				stack = append(stack, y)
+			}
+		}
+	}
+	return seen[v]
+}
+
+// TuttePolynomial returns the Tutte polynomial of a graph, where multiple
+// edges and loops (counted once on the diagonal of the adjacency matrix) are
+// allowed. The coefficient of x^i y^j is at position [i][j]. It is computed by
+// deletion and contraction: T(G) = x T(G / e) if e is a bridge, T(G) = y
+// T(G - e) if e is a loop, and T(G) = T(G - e) + T(G / e) otherwise.
+// Evaluations of the Tutte polynomial count, among others, spanning trees
+// (T(1, 1)), spanning forests (T(2, 1)) and acyclic orientations (T(2, 0)).
+func TuttePolynomial(g Graph) [][]*big.Int {
+	memo := make(map[string][][]*big.Int)
+	var tutte func(a graph.AdjacencyMatrix) [][]*big.Int
+	tutte = func(a graph.AdjacencyMatrix) [][]*big.Int {
+		// Loops factor out, and isolated vertices do not matter.
+		loops := 0
+		var isolated []int
+		for i := range a {
+			loops += int(a[i][i])
+			if rowDegree(a[i]) == 0 || (rowDegree(a[i]) == 1 && a[i][i] != 0) {
+				isolated = append(isolated, i)
+			}
+		}
+		if loops > 0 || len(isolated) > 0 {
+			b := make([][]byte, len(a))
+			for i := range a {
+				b[i] = append([]byte(nil), a[i]...)
+				b[i][i] = 0
+			}
+			p := tutte(deleteVertices(b, isolated...))
+			return addBivariate(nil, p, 0, loops)
+		}
+		u, v := firstEdge(a)
+		if u == -1 {
+			return [][]*big.Int{{big.NewInt(1)}}
+		}
+		key := certificate(a)
+		if p, ok := memo[key]; ok {
+			return p
+		}
+		var p [][]*big.Int
+		if a[u][v] == 1 && !connectedWithoutEdge(a, u, v) {
+			p = addBivariate(nil, tutte(contractEdge(a, u, v, false)), 1, 0)
+		} else {
+			p = addBivariate(tutte(deleteOneEdge(a, u, v)), tutte(contractEdge(a, u, v, false)), 0, 0)
+		}
+		memo[key] = p
+		return p
+	}
+	a := graph.MatrixOf(g)
+	b := make([][]byte, len(a))
+	for i := range a {
+		b[i] = append([]byte(nil), a[i]...)
+	}
+	return tutte(b)
+}
+
+// Writes a term c x^i y^j of a polynomial, given the variables and their
+// exponents, to a builder. The first term is written without a leading plus
+// sign.
+func writeTerm(b *strings.Builder, c *big.Int, variables []string, exponents []int) {
+	abs := new(big.Int).Abs(c)
+	if b.Len() == 0 {
+		if c.Sign() < 0 {
+			b.WriteString("-")
+		}
+	} else if c.Sign() < 0 {
+		b.WriteString(" - ")
+	} else {
+		b.WriteString(" + ")
+	}
+	constant := true
+	for _, e := range exponents {
+		if e > 0 {
+			constant = false
+		}
+	}
+	if constant || abs.Cmp(big.NewInt(1)) != 0 {
+		b.WriteString(abs.String())
+	}
+	for k, e := range exponents {
+		if e > 0 {
+			b.WriteString(variables[k])
+		}
+		if e > 1 {
+			b.WriteString("^")
+			b.WriteString(strconv.Itoa(e))
+		}
+	}
+}
+
+// FormatPolynomial returns a polynomial written in a variable with the terms
+// in decreasing degree, such as x^3 - 3x - 2.
+func FormatPolynomial(p []*big.Int, variable string) string {
+	var b strings.Builder
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i].Sign() != 0 {
+			writeTerm(&b, p[i], []string{variable}, []int{i})
+		}
+	}
+	if b.Len() == 0 {
+		return "0"
+	}
+	return b.String()
+}
+
+// FormatBivariatePolynomial returns a polynomial in two variables with the
+// terms in decreasing degree of the first variable and then of the second
+// one, such as x^2 + x + y.
+func FormatBivariatePolynomial(p [][]*big.Int, x, y string) string {
+	var b strings.Builder
+	for i := len(p) - 1; i >= 0; i-- {
+		for j := len(p[i]) - 1; j >= 0; j-- {
+			if p[i][j].Sign() != 0 {
+				writeTerm(&b, p[i][j], []string{x, y}, []int{i, j})
+			}
+		}
+	}
+	if b.Len() == 0 {
+		return "0"
+	}
+	return b.String()
+}
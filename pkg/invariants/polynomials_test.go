@@ -0,0 +1,186 @@
+package invariants
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns the value of a polynomial at an integer.
+func evaluate(p []*big.Int, x int64) *big.Int {
+	value := new(big.Int)
+	for i := len(p) - 1; i >= 0; i-- {
+		value.Mul(value, big.NewInt(x))
+		value.Add(value, p[i])
+	}
+	return value
+}
+
+// Returns the value of a polynomial in two variables at a pair of integers.
+func evaluateBivariate(p [][]*big.Int, x, y int64) *big.Int {
+	value := new(big.Int)
+	for i := len(p) - 1; i >= 0; i-- {
+		value.Mul(value, big.NewInt(x))
+		value.Add(value, evaluate(p[i], y))
+	}
+	return value
+}
+
+// TestPolynomialsOfKnownGraphs checks the polynomials of some well-known
+// graphs through their printed form.
+func TestPolynomialsOfKnownGraphs(t *testing.T) {
+	k3, k4 := generators.CompleteMatrixGraph(3), generators.CompleteListGraph(4)
+	cases := []struct {
+		name, got, expected string
+	}{
+		{"chromatic polynomial of K3", FormatPolynomial(ChromaticPolynomial(k3), "x"), "x^3 - 3x^2 + 2x"},
+		{"chromatic polynomial of C4", FormatPolynomial(ChromaticPolynomial(generators.MatrixCycle(4)), "x"),
+			"x^4 - 4x^3 + 6x^2 - 3x"},
+		{"chromatic polynomial of P4", FormatPolynomial(ChromaticPolynomial(generators.ListPath(4)), "x"),
+			"x^4 - 3x^3 + 3x^2 - x"},
+		{"independence polynomial of C5", FormatPolynomial(IndependencePolynomial(generators.MatrixCycle(5)), "x"),
+			"5x^2 + 5x + 1"},
+		{"independence polynomial of K(1,3)", FormatPolynomial(IndependencePolynomial(generators.StarMatrixGraph(3)), "x"),
+			"x^3 + 3x^2 + 4x + 1"},
+		{"matching polynomial of K4", FormatPolynomial(MatchingPolynomial(k4), "x"), "x^4 - 6x^2 + 3"},
+		{"Tutte polynomial of K3", FormatBivariatePolynomial(TuttePolynomial(k3), "x", "y"), "x^2 + x + y"},
+		{"Tutte polynomial of K4", FormatBivariatePolynomial(TuttePolynomial(k4), "x", "y"),
+			"x^3 + 3x^2 + 4xy + 2x + y^3 + 3y^2 + 2y"},
+		{"Tutte polynomial of a loop and a double edge",
+			FormatBivariatePolynomial(TuttePolynomial(graph.NewFromMatrix([][]byte{{1, 2}, {2, 0}})), "x", "y"),
+			"xy + y^2"},
+		{"characteristic polynomial of K3", FormatPolynomial(CharacteristicPolynomial(k3), "x"), "x^3 - 3x - 2"},
+		{"empty polynomial", FormatPolynomial(ChromaticPolynomial(graph.NewFromMatrix([][]byte{})), "t"), "1"},
+		{"zero polynomial", FormatPolynomial([]*big.Int{big.NewInt(0)}, "x"), "0"},
+		{"negative leading coefficient", FormatPolynomial(bigInts(1, 0, -1), "x"), "-x^2 + 1"},
+	}
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("Expected the %v to be %v, got %v", c.name, c.expected, c.got)
+		}
+	}
+	if p := ChromaticPolynomial(generators.PetersenMatrixGraph()); evaluate(p, 3).Int64() != 120 ||
+		evaluate(p, 2).Sign() != 0 {
+		t.Errorf("Expected 120 colourings of the Petersen graph with 3 colours and none with 2")
+	}
+	if p := TuttePolynomial(generators.PetersenMatrixGraph()); evaluateBivariate(p, 1, 1).Int64() != 2000 {
+		t.Errorf("Expected 2000 spanning trees of the Petersen graph, got %v", evaluateBivariate(p, 1, 1))
+	}
+}
+
+// TestPolynomialIdentities checks identities between the polynomials of
+// random graphs, and against brute force counts.
+func TestPolynomialIdentities(t *testing.T) {
+	r := rand.New(rand.NewSource(41))
+	for k := 0; k < 25; k++ {
+		n := 1 + r.Intn(7)
+		g, _ := generators.GnpRandomGraph(n, r.Float64(), r)
+		adj := adjacency(g)
+		m := g.Size()
+		tutte := TuttePolynomial(g)
+		chromatic := ChromaticPolynomial(g)
+		c := Components(g)
+
+		// T(2, 2) counts the spanning subgraphs.
+		if v := evaluateBivariate(tutte, 2, 2); v.Cmp(new(big.Int).Lsh(big.NewInt(1), uint(m))) != 0 {
+			t.Errorf("Expected T(2, 2) = 2^%v, got %v", m, v)
+		}
+		// P(G, k) = (-1)^(n-c) k^c T(1 - k, 0).
+		for colours := int64(0); colours < 4; colours++ {
+			expected := evaluateBivariate(tutte, 1-colours, 0)
+			expected.Mul(expected, new(big.Int).Exp(big.NewInt(colours), big.NewInt(int64(c)), nil))
+			if (n-c)%2 == 1 {
+				expected.Neg(expected)
+			}
+			if got := evaluate(chromatic, colours); got.Cmp(expected) != 0 {
+				t.Errorf("Expected P(G, %v) = %v from the Tutte polynomial, got %v", colours, expected, got)
+			}
+		}
+		// Count the proper 3-colourings, the independent sets and the
+		// matchings by brute force.
+		colourings := int64(0)
+		colours := make([]int, n)
+		for code := 0; code < pow(3, n); code++ {
+			x := code
+			for v := range colours {
+				colours[v], x = x%3, x/3
+			}
+			proper := true
+			for v := range adj {
+				for w, b := range adj[v] {
+					if b && colours[v] == colours[w] {
+						proper = false
+					}
+				}
+			}
+			if proper {
+				colourings++
+			}
+		}
+		if evaluate(chromatic, 3).Int64() != colourings {
+			t.Errorf("Expected %v colourings with 3 colours, got %v", colourings, evaluate(chromatic, 3))
+		}
+		independentSets := int64(0)
+		for mask := 0; mask < 1<<n; mask++ {
+			if edges, _ := inspectSubset(adj, mask); edges == 0 {
+				independentSets++
+			}
+		}
+		if evaluate(IndependencePolynomial(g), 1).Int64() != independentSets {
+			t.Errorf("Expected %v independent sets, got %v", independentSets, evaluate(IndependencePolynomial(g), 1))
+		}
+		var edges [][2]int
+		for i := range adj {
+			for j := i + 1; j < n; j++ {
+				if adj[i][j] {
+					edges = append(edges, [2]int{i, j})
+				}
+			}
+		}
+		matchings := make([]int64, n/2+1)
+		for mask := 0; mask < 1<<len(edges); mask++ {
+			used, size, valid := 0, 0, true
+			for e, edge := range edges {
+				if mask&(1<<e) != 0 {
+					if used&(1<<edge[0]) != 0 || used&(1<<edge[1]) != 0 {
+						valid = false
+						break
+					}
+					used |= 1<<edge[0] | 1<<edge[1]
+					size++
+				}
+			}
+			if valid {
+				matchings[size]++
+			}
+		}
+		matching := MatchingPolynomial(g)
+		for size, count := range matchings {
+			expected := count
+			if size%2 == 1 {
+				expected = -count
+			}
+			if n-2*size < len(matching) && matching[n-2*size].Int64() != expected {
+				t.Errorf("Expected coefficient %v of x^%v in %v", expected, n-2*size, FormatPolynomial(matching, "x"))
+			}
+		}
+	}
+	// For trees, the matching polynomial is the characteristic polynomial.
+	generators.FreeTrees(8, func(tree *graph.StaticGraph) {
+		if !equalPolynomials(MatchingPolynomial(tree), CharacteristicPolynomial(tree)) {
+			t.Errorf("Expected equal matching and characteristic polynomials of a tree")
+		}
+	})
+}
+
+// Returns b^e for non-negative integers.
+func pow(b, e int) int {
+	p := 1
+	for i := 0; i < e; i++ {
+		p *= b
+	}
+	return p
+}
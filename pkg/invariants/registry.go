@@ -0,0 +1,101 @@
+// Package invariants provides numeric invariants of graphs, which do not
+// depend on the labels of the vertices. Every invariant is registered by name,
+// so all of them can be queried uniformly.
+package invariants
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+type Graph = graph.Graph
+
+// An Invariant is a numeric invariant of graphs registered by name.
+type Invariant struct {
+	// Name is the name the invariant is registered with.
+	Name string
+
+	// Description is a short description of the invariant.
+	Description string
+
+	// Compute returns the value of the invariant for a graph.
+	Compute func(Graph) float64
+}
+
+// The registered invariants, by name.
+var registry = make(map[string]*Invariant)
+
+// Register registers an invariant with a given name and description. If there
+// is already an invariant with that name, an error is returned.
+func Register(name, description string, compute func(Graph) float64) error {
+	if _, ok := registry[name]; ok {
+		return DuplicateInvariant
+	}
+	registry[name] = &Invariant{
+		Name:        name,
+		Description: description,
+		Compute:     compute,
+	}
+	return nil
+}
+
+// Lookup returns the invariant registered with a given name. If there is no
+// such invariant, an error is returned.
+func Lookup(name string) (*Invariant, error) {
+	invariant, ok := registry[name]
+	if !ok {
+		return nil, UnknownInvariant
+	}
+	return invariant, nil
+}
+
+// Names returns the names of the registered invariants in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Compute returns the value of the invariant registered with a given name for
+// a graph. If there is no such invariant, an error is returned.
+func Compute(name string, g Graph) (float64, error) {
+	invariant, err := Lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	return invariant.Compute(g), nil
+}
+
+// Converts an integer invariant into a numeric one.
+func integer(f func(Graph) int) func(Graph) float64 {
+	return func(g Graph) float64 {
+		return float64(f(g))
+	}
+}
+
+func init() {
+	Register("triangles", "Number of triangles", integer(Triangles))
+	Register("clustering", "Average clustering coefficient", ClusteringCoefficient)
+	Register("independence", "Independence number", integer(IndependenceNumber))
+	Register("clique", "Clique number", integer(CliqueNumber))
+	Register("chromatic", "Chromatic number", integer(ChromaticNumber))
+	Register("domination", "Domination number", integer(DominationNumber))
+	Register("degeneracy", "Degeneracy", integer(Degeneracy))
+	Register("components", "Number of connected components", integer(Components))
+	Register("girth", "Length of a shortest cycle, infinite for forests", func(g Graph) float64 {
+		if girth := Girth(g); girth > 0 {
+			return float64(girth)
+		}
+		return math.Inf(1)
+	})
+	Register("circumference", "Length of a longest cycle, 0 for forests", integer(Circumference))
+	Register("chromatic-index", "Edge chromatic number", integer(ChromaticIndex))
+	Register("spectral-radius", "Largest eigenvalue of the adjacency matrix", SpectralRadius)
+	Register("energy", "Sum of the absolute values of the adjacency eigenvalues", Energy)
+	Register("algebraic-connectivity", "Second smallest Laplacian eigenvalue", AlgebraicConnectivity)
+}
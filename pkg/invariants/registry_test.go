@@ -0,0 +1,44 @@
+package invariants
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+)
+
+// TestRegistry checks that the invariants can be queried by name, and that
+// names cannot be registered twice.
+func TestRegistry(t *testing.T) {
+	petersen := generators.PetersenMatrixGraph()
+	expected := map[string]float64{
+		"triangles": 0, "clustering": 0, "independence": 4, "clique": 2, "chromatic": 3,
+		"domination": 3, "degeneracy": 3, "components": 1, "girth": 5,
+		"circumference": 9, "chromatic-index": 4,
+	}
+	for name, value := range expected {
+		if got, err := Compute(name, petersen); err != nil || got != value {
+			t.Errorf("Expected %v of the Petersen graph to be %v, got %v (%v)", name, value, got, err)
+		}
+	}
+	if len(Names()) < len(expected) {
+		t.Errorf("Expected at least %v invariants, got %v", len(expected), Names())
+	}
+	if girth, _ := Compute("girth", generators.StarMatrixGraph(3)); !math.IsInf(girth, 1) {
+		t.Errorf("Expected infinite girth for a tree, got %v", girth)
+	}
+	if _, err := Compute("unknown", petersen); err != UnknownInvariant {
+		t.Errorf("Expected %v, got %v", UnknownInvariant, err)
+	}
+	if err := Register("order", "Number of vertices", func(g Graph) float64 {
+		return float64(g.Order())
+	}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if order, _ := Compute("order", petersen); order != 10 {
+		t.Errorf("Expected 10, got %v", order)
+	}
+	if err := Register("order", "", nil); err != DuplicateInvariant {
+		t.Errorf("Expected %v, got %v", DuplicateInvariant, err)
+	}
+}
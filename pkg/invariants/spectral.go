@@ -0,0 +1,291 @@
+package invariants
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// The spectral invariants are computed from three matrices of a graph: its
+// adjacency matrix A, its Laplacian matrix D - A and its signless Laplacian
+// matrix D + A, where D is the diagonal matrix of the degrees. Loops are
+// ignored in the Laplacian matrices, and multiple edges are counted with their
+// multiplicity. The characteristic polynomials are exact, while the
+// eigenvalues are approximated numerically. The numerical methods need a
+// symmetric matrix, so the spectra of a digraph are those of its underlying
+// graph, where two vertices are joined by as many edges as the larger number
+// of arcs between them in either direction.
+
+// Returns the adjacency matrix of a graph as integers.
+func adjacencyInts(g Graph) [][]int64 {
+	a := graph.MatrixOf(g)
+	m := make([][]int64, len(a))
+	for i := range a {
+		m[i] = make([]int64, len(a))
+		for j, w := range a[i] {
+			m[i][j] = int64(w)
+		}
+	}
+	return m
+}
+
+// Returns the adjacency matrix of the graph underlying a graph or a digraph as
+// integers, which is symmetric.
+func underlyingInts(g Graph) [][]int64 {
+	m := adjacencyInts(g)
+	for i := range m {
+		for j := i + 1; j < len(m); j++ {
+			if m[j][i] > m[i][j] {
+				m[i][j] = m[j][i]
+			}
+			m[j][i] = m[i][j]
+		}
+	}
+	return m
+}
+
+// Returns the Laplacian matrix of a graph, or the signless Laplacian matrix if
+// sign is 1.
+func laplacianInts(g Graph, sign int64) [][]int64 {
+	return laplacian(adjacencyInts(g), sign)
+}
+
+// Turns an adjacency matrix into the Laplacian matrix, or the signless
+// Laplacian matrix if sign is 1, and returns it.
+func laplacian(m [][]int64, sign int64) [][]int64 {
+	for i := range m {
+		m[i][i] = 0
+		degree := int64(0)
+		for j := range m[i] {
+			degree += m[i][j]
+			m[i][j] *= sign
+		}
+		m[i][i] = degree
+	}
+	return m
+}
+
+// LaplacianMatrix returns the Laplacian matrix D - A of a graph.
+func LaplacianMatrix(g Graph) [][]int64 {
+	return laplacianInts(g, -1)
+}
+
+// SignlessLaplacianMatrix returns the signless Laplacian matrix D + A of a
+// graph.
+func SignlessLaplacianMatrix(g Graph) [][]int64 {
+	return laplacianInts(g, 1)
+}
+
+// Returns the characteristic polynomial det(xI - M) of an integer matrix,
+// using the method of Faddeev and LeVerrier, where every division is exact.
+// The coefficient of x^i is at position i.
+func characteristicPolynomial(m [][]int64) []*big.Int {
+	n := len(m)
+	a := make([][]*big.Int, n)
+	for i := range m {
+		a[i] = make([]*big.Int, n)
+		for j := range m[i] {
+			a[i][j] = big.NewInt(m[i][j])
+		}
+	}
+	c := make([]*big.Int, n+1)
+	c[n] = big.NewInt(1)
+
+	// M_k = A M_{k-1} + c_{n-k+1} I, and c_{n-k} = -tr(A M_k) / k.
+	previous := make([][]*big.Int, n)
+	for i := range previous {
+		previous[i] = make([]*big.Int, n)
+		for j := range previous[i] {
+			previous[i][j] = new(big.Int)
+		}
+	}
+	for k := 1; k <= n; k++ {
+		current := make([][]*big.Int, n)
+		for i := range current {
+			current[i] = make([]*big.Int, n)
+			for j := range current[i] {
+				sum := new(big.Int)
+				for l := 0; l < n; l++ {
+					if a[i][l].Sign() != 0 {
+						sum.Add(sum, new(big.Int).Mul(a[i][l], previous[l][j]))
+					}
+				}
+				if i == j {
+					sum.Add(sum, c[n-k+1])
+				}
+				current[i][j] = sum
+			}
+		}
+		trace := new(big.Int)
+		for i := 0; i < n; i++ {
+			for l := 0; l < n; l++ {
+				trace.Add(trace, new(big.Int).Mul(a[i][l], current[l][i]))
+			}
+		}
+		c[n-k] = trace.Neg(trace).Quo(trace, big.NewInt(int64(k)))
+		previous = current
+	}
+	return c
+}
+
+// CharacteristicPolynomial returns the characteristic polynomial of the
+// adjacency matrix of a graph or a digraph, with exact integer coefficients.
+// The coefficient of x^i is at position i.
+func CharacteristicPolynomial(g Graph) []*big.Int {
+	return characteristicPolynomial(adjacencyInts(g))
+}
+
+// LaplacianCharacteristicPolynomial returns the characteristic polynomial of
+// the Laplacian matrix of a graph, with exact integer coefficients. The
+// coefficient of x^i is at position i.
+func LaplacianCharacteristicPolynomial(g Graph) []*big.Int {
+	return characteristicPolynomial(LaplacianMatrix(g))
+}
+
+// SignlessLaplacianCharacteristicPolynomial returns the characteristic
+// polynomial of the signless Laplacian matrix of a graph, with exact integer
+// coefficients. The coefficient of x^i is at position i.
+func SignlessLaplacianCharacteristicPolynomial(g Graph) []*big.Int {
+	return characteristicPolynomial(SignlessLaplacianMatrix(g))
+}
+
+// Returns the eigenvalues of a symmetric matrix in non-increasing order,
+// using the cyclic Jacobi method: every off-diagonal entry is annihilated in
+// turn by a rotation, until the matrix is diagonal up to rounding.
+func symmetricEigenvalues(m [][]int64) []float64 {
+	n := len(m)
+	a := make([][]float64, n)
+	norm := 0.0
+	for i := range m {
+		a[i] = make([]float64, n)
+		for j := range m[i] {
+			a[i][j] = float64(m[i][j])
+			norm += a[i][j] * a[i][j]
+		}
+	}
+	for sweep := 0; sweep < 100; sweep++ {
+		off := 0.0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i][j] * a[i][j]
+			}
+		}
+		if off <= 1e-24*norm {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p], a[k][q] = c*akp-s*akq, s*akp+c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k], a[q][k] = c*apk-s*aqk, s*apk+c*aqk
+				}
+			}
+		}
+	}
+	eigenvalues := make([]float64, n)
+	for i := range eigenvalues {
+		eigenvalues[i] = a[i][i]
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(eigenvalues)))
+	return eigenvalues
+}
+
+// Spectrum returns the eigenvalues of the adjacency matrix of a graph in
+// non-increasing order. For a digraph, these are the eigenvalues of its
+// underlying graph.
+func Spectrum(g Graph) []float64 {
+	return symmetricEigenvalues(underlyingInts(g))
+}
+
+// LaplacianSpectrum returns the eigenvalues of the Laplacian matrix of a graph
+// in non-increasing order. For a digraph, these are the eigenvalues of its
+// underlying graph.
+func LaplacianSpectrum(g Graph) []float64 {
+	return symmetricEigenvalues(laplacian(underlyingInts(g), -1))
+}
+
+// SignlessLaplacianSpectrum returns the eigenvalues of the signless Laplacian
+// matrix of a graph in non-increasing order. For a digraph, these are the
+// eigenvalues of its underlying graph.
+func SignlessLaplacianSpectrum(g Graph) []float64 {
+	return symmetricEigenvalues(laplacian(underlyingInts(g), 1))
+}
+
+// SpectralRadius returns the largest eigenvalue of the adjacency matrix of a
+// graph, which is also the largest in absolute value.
+func SpectralRadius(g Graph) float64 {
+	if g.Order() == 0 {
+		return 0
+	}
+	return Spectrum(g)[0]
+}
+
+// Energy returns the energy of a graph, the sum of the absolute values of the
+// eigenvalues of its adjacency matrix.
+func Energy(g Graph) float64 {
+	energy := 0.0
+	for _, lambda := range Spectrum(g) {
+		energy += math.Abs(lambda)
+	}
+	return energy
+}
+
+// AlgebraicConnectivity returns the second smallest eigenvalue of the
+// Laplacian matrix of a graph, which is positive if and only if the graph is
+// connected. It is 0 for graphs with less than two vertices.
+func AlgebraicConnectivity(g Graph) float64 {
+	spectrum := LaplacianSpectrum(g)
+	if len(spectrum) < 2 {
+		return 0
+	}
+	return spectrum[len(spectrum)-2]
+}
+
+// Returns whether two polynomials have the same coefficients.
+func equalPolynomials(p, q []*big.Int) bool {
+	if len(p) != len(q) {
+		return false
+	}
+	for i := range p {
+		if p[i].Cmp(q[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// AreCospectral returns whether two graphs have the same adjacency spectrum,
+// by comparing their exact characteristic polynomials.
+func AreCospectral(g, h Graph) bool {
+	return equalPolynomials(CharacteristicPolynomial(g), CharacteristicPolynomial(h))
+}
+
+// AreLaplacianCospectral returns whether two graphs have the same Laplacian
+// spectrum, by comparing their exact characteristic polynomials.
+func AreLaplacianCospectral(g, h Graph) bool {
+	return equalPolynomials(LaplacianCharacteristicPolynomial(g), LaplacianCharacteristicPolynomial(h))
+}
+
+// AreSignlessLaplacianCospectral returns whether two graphs have the same
+// signless Laplacian spectrum, by comparing their exact characteristic
+// polynomials.
+func AreSignlessLaplacianCospectral(g, h Graph) bool {
+	return equalPolynomials(SignlessLaplacianCharacteristicPolynomial(g),
+		SignlessLaplacianCharacteristicPolynomial(h))
+}
@@ -0,0 +1,166 @@
+package invariants
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/operations"
+)
+
+// Returns whether two slices of floats are equal up to rounding.
+func approximatelyEqual(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > 1e-8 {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the polynomial with the given integer coefficients.
+func bigInts(coefficients ...int64) []*big.Int {
+	p := make([]*big.Int, len(coefficients))
+	for i, c := range coefficients {
+		p[i] = big.NewInt(c)
+	}
+	return p
+}
+
+// TestCharacteristicPolynomial checks some characteristic polynomials, and the
+// number of spanning trees given by the Laplacian polynomial.
+func TestCharacteristicPolynomial(t *testing.T) {
+	if p := CharacteristicPolynomial(generators.CompleteMatrixGraph(3)); !equalPolynomials(p, bigInts(-2, -3, 0, 1)) {
+		t.Errorf("Expected x^3 - 3x - 2, got %v", p)
+	}
+	if p := CharacteristicPolynomial(generators.MatrixDirectedCycle(4)); !equalPolynomials(p, bigInts(-1, 0, 0, 0, 1)) {
+		t.Errorf("Expected x^4 - 1, got %v", p)
+	}
+	if p := LaplacianCharacteristicPolynomial(generators.ListPath(3)); !equalPolynomials(p, bigInts(0, 3, -4, 1)) {
+		t.Errorf("Expected x^3 - 4x^2 + 3x, got %v", p)
+	}
+	// By the matrix tree theorem, the coefficient of x in the Laplacian
+	// polynomial of K_n is (-1)^(n-1) n times n^(n-2) spanning trees.
+	for n := 2; n < 12; n++ {
+		p := LaplacianCharacteristicPolynomial(generators.CompleteListGraph(n))
+		trees := new(big.Int).Exp(big.NewInt(int64(n)), big.NewInt(int64(n-1)), nil)
+		if n%2 == 0 {
+			trees.Neg(trees)
+		}
+		if p[1].Cmp(trees) != 0 {
+			t.Errorf("Expected coefficient %v of x for K%v, got %v", trees, n, p[1])
+		}
+	}
+}
+
+// TestSpectra checks the spectra of some well-known graphs.
+func TestSpectra(t *testing.T) {
+	if s := Spectrum(generators.PetersenMatrixGraph()); !approximatelyEqual(s,
+		[]float64{3, 1, 1, 1, 1, 1, -2, -2, -2, -2}) {
+		t.Errorf("Unexpected spectrum of the Petersen graph %v", s)
+	}
+	if s := LaplacianSpectrum(generators.CompleteMatrixGraph(5)); !approximatelyEqual(s,
+		[]float64{5, 5, 5, 5, 0}) {
+		t.Errorf("Unexpected Laplacian spectrum of K5 %v", s)
+	}
+	if s := SignlessLaplacianSpectrum(generators.CompleteBipartiteMatrixGraph(2, 3)); !approximatelyEqual(s,
+		LaplacianSpectrum(generators.CompleteBipartiteMatrixGraph(2, 3))) {
+		t.Errorf("Expected equal Laplacian spectra for a bipartite graph, got %v", s)
+	}
+	cycle := Spectrum(generators.MatrixCycle(7))
+	for _, lambda := range cycle {
+		found := false
+		for k := 0; k < 7; k++ {
+			if math.Abs(lambda-2*math.Cos(2*math.Pi*float64(k)/7)) < 1e-8 {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Unexpected eigenvalue %v of C7", lambda)
+		}
+	}
+	if e := Energy(generators.CompleteMatrixGraph(6)); math.Abs(e-10) > 1e-8 {
+		t.Errorf("Expected energy 10 of K6, got %v", e)
+	}
+	if r := SpectralRadius(generators.StarMatrixGraph(4)); math.Abs(r-2) > 1e-8 {
+		t.Errorf("Expected spectral radius 2 of K(1,4), got %v", r)
+	}
+	if a := AlgebraicConnectivity(generators.MatrixPath(6)); math.Abs(a-(2-2*math.Cos(math.Pi/6))) > 1e-8 {
+		t.Errorf("Expected algebraic connectivity %v of P6, got %v", 2-2*math.Cos(math.Pi/6), a)
+	}
+}
+
+// TestDigraphSpectra checks that the spectra of digraphs are those of their
+// underlying graphs.
+func TestDigraphSpectra(t *testing.T) {
+	if s := Spectrum(generators.MatrixDirectedCycle(5)); !approximatelyEqual(s,
+		Spectrum(generators.MatrixCycle(5))) {
+		t.Errorf("Expected the spectrum of C5, got %v", s)
+	}
+	if s := LaplacianSpectrum(generators.ListDirectedPath(6)); !approximatelyEqual(s,
+		LaplacianSpectrum(generators.ListPath(6))) {
+		t.Errorf("Expected the Laplacian spectrum of P6, got %v", s)
+	}
+	if s := SignlessLaplacianSpectrum(generators.CompleteMatrixDigraph(4)); !approximatelyEqual(s,
+		SignlessLaplacianSpectrum(generators.CompleteMatrixGraph(4))) {
+		t.Errorf("Expected the signless Laplacian spectrum of K4, got %v", s)
+	}
+	tournament, _ := generators.RandomTournament(6, rand.New(rand.NewSource(40)))
+	if e := Energy(tournament); math.Abs(e-10) > 1e-8 {
+		t.Errorf("Expected energy 10 of a tournament of order 6, got %v", e)
+	}
+	if r := SpectralRadius(tournament); math.Abs(r-5) > 1e-8 {
+		t.Errorf("Expected spectral radius 5 of a tournament of order 6, got %v", r)
+	}
+	if a := AlgebraicConnectivity(generators.MatrixDirectedPath(6)); math.Abs(a-(2-2*math.Cos(math.Pi/6))) > 1e-8 {
+		t.Errorf("Expected algebraic connectivity %v of the directed P6, got %v", 2-2*math.Cos(math.Pi/6), a)
+	}
+}
+
+// TestSpectralMoments checks that the power sums of the eigenvalues of random
+// graphs count closed walks, and that they agree with the exact characteristic
+// polynomials: the coefficient of x^(n-2) is minus the size, and the
+// coefficient of x^(n-3) is minus twice the number of triangles.
+func TestSpectralMoments(t *testing.T) {
+	r := rand.New(rand.NewSource(40))
+	for k := 0; k < 30; k++ {
+		n := 3 + r.Intn(10)
+		g, _ := generators.GnpRandomGraph(n, r.Float64(), r)
+		squares, cubes := 0.0, 0.0
+		for _, lambda := range Spectrum(g) {
+			squares += lambda * lambda
+			cubes += lambda * lambda * lambda
+		}
+		m, triangles := g.Size(), Triangles(g)
+		if math.Abs(squares-float64(2*m)) > 1e-6 || math.Abs(cubes-float64(6*triangles)) > 1e-6 {
+			t.Errorf("Expected power sums %v and %v, got %v and %v", 2*m, 6*triangles,
+				squares, cubes)
+		}
+		p := CharacteristicPolynomial(g)
+		if p[n-2].Int64() != int64(-m) || p[n-3].Int64() != int64(-2*triangles) {
+			t.Errorf("Expected coefficients %v and %v, got %v and %v", -m, -2*triangles,
+				p[n-2], p[n-3])
+		}
+	}
+}
+
+// TestCospectral checks the smallest pair of cospectral graphs, the star
+// K(1,4) and the disjoint union of C4 and K1.
+func TestCospectral(t *testing.T) {
+	star := generators.StarMatrixGraph(4)
+	union := operations.DisjointUnion(generators.MatrixCycle(4), generators.CompleteMatrixGraph(1))
+	if !AreCospectral(star, union) {
+		t.Errorf("Expected K(1,4) and C4 + K1 to be cospectral")
+	}
+	if AreLaplacianCospectral(star, union) || AreSignlessLaplacianCospectral(star, generators.MatrixPath(5)) {
+		t.Errorf("Expected K(1,4) not to be Laplacian cospectral with C4 + K1 nor P5")
+	}
+	if AreCospectral(star, generators.MatrixPath(5)) {
+		t.Errorf("Expected K(1,4) and P5 not to be cospectral")
+	}
+}
@@ -0,0 +1,435 @@
+// Package isomorphism provides canonical labelling, isomorphism testing and
+// subgraph search of graphs and digraphs.
+package isomorphism
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+type Graph = graph.Graph
+
+// The canonical labelling is computed with the individualization-refinement
+// scheme of McKay. The vertices are coloured by an ordered partition, which is
+// refined until it is equitable; then a vertex of the first smallest
+// non-trivial cell is individualized and the process is repeated, until every
+// cell is a singleton. Each such discrete partition is a labelling of the
+// vertices, and the canonical labelling is the one whose relabelled adjacency
+// matrix is the greatest. The automorphisms found along the way are used to
+// prune the search.
+
+// The state of the search for the canonical labelling of a matrix.
+type search struct {
+	matrix    graph.AdjacencyMatrix
+	symmetric bool
+
+	// The individualized vertices of the current node and of the first leaf.
+	path      []int
+	firstPath []int
+
+	// The labellings and the relabelled matrices of the first leaf and of the
+	// greatest leaf found so far.
+	first     []int
+	firstCert []byte
+	best      []int
+	bestCert  []byte
+
+	// Generators of the automorphisms found so far.
+	generators [][]int
+}
+
+// Returns whether a matrix is symmetric.
+func isSymmetric(a graph.AdjacencyMatrix) bool {
+	for i := range a {
+		for j := 0; j < i; j++ {
+			if a[i][j] != a[j][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns the colours of an equitable ordered partition that refines the
+// given one. Every vertex is distinguished by its colour and by the number of
+// arcs going to (and coming from) each colour, and the new colours are given
+// by sorting these signatures, so the result does not depend on the labels of
+// the vertices.
+func (s *search) refine(colours []int) []int {
+	n := len(colours)
+	k := 0
+	for _, c := range colours {
+		if c+1 > k {
+			k = c + 1
+		}
+	}
+	width := 1 + k
+	if !s.symmetric {
+		width += k
+	}
+	for {
+		signatures := make([][]int, n)
+		for v := range signatures {
+			signatures[v] = make([]int, width)
+			signatures[v][0] = colours[v]
+		}
+		for v, row := range s.matrix {
+			for w, entry := range row {
+				if entry != 0 {
+					signatures[v][1+colours[w]] += int(entry)
+					if !s.symmetric {
+						signatures[w][1+k+colours[v]] += int(entry)
+					}
+				}
+			}
+		}
+		order := make([]int, n)
+		for v := range order {
+			order[v] = v
+		}
+		sort.Slice(order, func(i, j int) bool {
+			return compareInts(signatures[order[i]], signatures[order[j]]) < 0
+		})
+		refined := make([]int, n)
+		cells := 0
+		for i, v := range order {
+			if i > 0 && compareInts(signatures[order[i-1]], signatures[v]) != 0 {
+				cells++
+			}
+			refined[v] = cells
+		}
+		if n > 0 {
+			cells++
+		}
+		if cells == k {
+			return refined
+		}
+		colours, k = refined, cells
+		width = 1 + k
+		if !s.symmetric {
+			width += k
+		}
+	}
+}
+
+// Compares two slices of integers of the same length lexicographically.
+func compareInts(a, b []int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Returns the vertices of the first smallest cell with more than one vertex,
+// in increasing order, or nil if the partition is discrete.
+func targetCell(colours []int) []int {
+	cells := make([][]int, len(colours))
+	for v, c := range colours {
+		cells[c] = append(cells[c], v)
+	}
+	var target []int
+	for _, cell := range cells {
+		if len(cell) > 1 && (target == nil || len(cell) < len(target)) {
+			target = cell
+		}
+	}
+	return target
+}
+
+// Returns the partition obtained by placing a vertex in a cell of its own,
+// right before the rest of its cell.
+func individualize(colours []int, v int) []int {
+	c := colours[v]
+	result := make([]int, len(colours))
+	for w, d := range colours {
+		if d > c || (d == c && w != v) {
+			d++
+		}
+		result[w] = d
+	}
+	return result
+}
+
+// Returns the matrix relabelled by a labelling, as a byte slice.
+func (s *search) relabel(labels []int) []byte {
+	n := len(labels)
+	cert := make([]byte, n*n)
+	for i, row := range s.matrix {
+		for j, entry := range row {
+			cert[labels[i]*n+labels[j]] = entry
+		}
+	}
+	return cert
+}
+
+// Returns the automorphism mapping each vertex to the vertex with its label in
+// another labelling with the same relabelled matrix.
+func automorphism(labels, target []int) []int {
+	inverse := make([]int, len(target))
+	for v, l := range target {
+		inverse[l] = v
+	}
+	gamma := make([]int, len(labels))
+	identity := true
+	for v, l := range labels {
+		gamma[v] = inverse[l]
+		if gamma[v] != v {
+			identity = false
+		}
+	}
+	if identity {
+		return nil
+	}
+	return gamma
+}
+
+// Processes a leaf of the search tree. Returns the depth the search must go
+// back to: if the leaf is equivalent to the first one, there is an
+// automorphism fixing the common prefix of both paths, so the rest of the
+// subtree at that node is equivalent to an explored one.
+func (s *search) leaf(colours []int, depth int) int {
+	labels := make([]int, len(colours))
+	copy(labels, colours)
+	cert := s.relabel(labels)
+	if s.first == nil {
+		s.first, s.firstCert = labels, cert
+		s.best, s.bestCert = labels, cert
+		s.firstPath = append([]int(nil), s.path...)
+		return depth
+	}
+	if bytes.Equal(cert, s.firstCert) {
+		if gamma := automorphism(labels, s.first); gamma != nil {
+			s.generators = append(s.generators, gamma)
+		}
+		common := 0
+		for common < len(s.path) && common < len(s.firstPath) &&
+			s.path[common] == s.firstPath[common] {
+			common++
+		}
+		return common
+	}
+	switch bytes.Compare(cert, s.bestCert) {
+	case 1:
+		s.best, s.bestCert = labels, cert
+	case 0:
+		if gamma := automorphism(labels, s.best); gamma != nil {
+			s.generators = append(s.generators, gamma)
+		}
+	}
+	return depth
+}
+
+// Returns the root of a vertex in a union-find forest.
+func find(parent []int, v int) int {
+	for parent[v] != v {
+		parent[v] = parent[parent[v]]
+		v = parent[v]
+	}
+	return parent[v]
+}
+
+// Returns the orbits of the group generated by the given permutations, as a
+// union-find forest.
+func orbitForest(n int, generators [][]int) []int {
+	parent := make([]int, n)
+	for v := range parent {
+		parent[v] = v
+	}
+	for _, gamma := range generators {
+		for v, w := range gamma {
+			if a, b := find(parent, v), find(parent, w); a != b {
+				if a < b {
+					parent[b] = a
+				} else {
+					parent[a] = b
+				}
+			}
+		}
+	}
+	return parent
+}
+
+// Returns whether a vertex of the target cell at the given depth must be
+// explored, that is, whether it is not in the orbit of an already explored
+// vertex under the automorphisms found so far that fix the current path.
+func (s *search) mustExplore(v int, explored []int, depth int) bool {
+	var stabilizer [][]int
+	for _, gamma := range s.generators {
+		fixes := true
+		for _, w := range s.path[:depth] {
+			if gamma[w] != w {
+				fixes = false
+				break
+			}
+		}
+		if fixes {
+			stabilizer = append(stabilizer, gamma)
+		}
+	}
+	parent := orbitForest(len(s.matrix), stabilizer)
+	for _, w := range explored {
+		if find(parent, w) == find(parent, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Explores the node of the search tree with the given partition, returning
+// the depth the search must go back to.
+func (s *search) explore(colours []int, depth int) int {
+	colours = s.refine(colours)
+	cell := targetCell(colours)
+	if cell == nil {
+		return s.leaf(colours, depth)
+	}
+	for i, v := range cell {
+		if i > 0 && !s.mustExplore(v, cell[:i], depth) {
+			continue
+		}
+		s.path = append(s.path[:depth], v)
+		if back := s.explore(individualize(colours, v), depth+1); back < depth {
+			return back
+		}
+	}
+	return depth
+}
+
+// Runs the search on a matrix with an initial colouring, whose colours must
+// be the integers in [0, k) for some k.
+func canonize(a graph.AdjacencyMatrix, colours []int) *search {
+	s := &search{
+		matrix:    a,
+		symmetric: isSymmetric(a),
+	}
+	s.explore(colours, 0)
+	return s
+}
+
+// Returns the colours of a colouring replaced by their ranks among the
+// distinct colours, which is what the search expects.
+func colourRanks(colours []int) []int {
+	values := append([]int(nil), colours...)
+	sort.Ints(values)
+	rank := make(map[int]int)
+	for _, c := range values {
+		if _, ok := rank[c]; !ok {
+			rank[c] = len(rank)
+		}
+	}
+	ranks := make([]int, len(colours))
+	for v, c := range colours {
+		ranks[v] = rank[c]
+	}
+	return ranks
+}
+
+// Canonize returns the canonical labelling of a graph, together with its
+// certificate. The labelling maps every vertex to its position in the
+// canonical order, so relabelling the graph with it gives its canonical
+// form. Two graphs are isomorphic if and only if they have the same
+// certificate, which is their adjacency matrix relabelled canonically.
+// Digraphs, loops and multiple edges are supported.
+func Canonize(g Graph) (graph.Permutation, string) {
+	a := graph.MatrixOf(g)
+	s := canonize(a, make([]int, len(a)))
+	return graph.Permutation(s.best), string(s.bestCert)
+}
+
+// ColouredCanonize returns the canonical labelling of a graph whose vertices
+// are coloured, together with its certificate. Only the permutations of the
+// vertices that preserve the colours are considered, so two coloured graphs
+// have the same certificate if and only if there is an isomorphism between
+// them mapping every vertex to a vertex with the same colour. If the colouring
+// does not have a colour for every vertex, an error is returned.
+func ColouredCanonize(g Graph, colours []int) (graph.Permutation, string, error) {
+	a := graph.MatrixOf(g)
+	if len(colours) != len(a) {
+		return nil, "", InvalidColouring
+	}
+	s := canonize(a, colourRanks(colours))
+	inverse := make([]int, len(a))
+	for v, l := range s.best {
+		inverse[l] = v
+	}
+	var cert bytes.Buffer
+	cert.Write(s.bestCert)
+	for _, v := range inverse {
+		cert.WriteString(",")
+		cert.WriteString(strconv.Itoa(colours[v]))
+	}
+	return graph.Permutation(s.best), cert.String(), nil
+}
+
+// CanonicalLabeling returns the canonical labelling of a graph, the
+// permutation mapping every vertex to its position in the canonical order.
+func CanonicalLabeling(g Graph) graph.Permutation {
+	labels, _ := Canonize(g)
+	return labels
+}
+
+// Certificate returns a string which is the same for two graphs if and only if
+// they are isomorphic.
+func Certificate(g Graph) string {
+	_, cert := Canonize(g)
+	return cert
+}
+
+// ColouredCertificate returns a string which is the same for two coloured
+// graphs if and only if there is a colour-preserving isomorphism between them.
+func ColouredCertificate(g Graph, colours []int) (string, error) {
+	_, cert, err := ColouredCanonize(g, colours)
+	return cert, err
+}
+
+// CanonicalForm returns the adjacency matrix of a graph relabelled by its
+// canonical labelling, which is the same for isomorphic graphs.
+func CanonicalForm(g Graph) graph.AdjacencyMatrix {
+	labels, cert := Canonize(g)
+	n := len(labels)
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = []byte(cert[i*n : (i+1)*n])
+	}
+	return a
+}
+
+// AreIsomorphic returns whether two graphs are isomorphic.
+func AreIsomorphic(g, h Graph) bool {
+	if g.Order() != h.Order() {
+		return false
+	}
+	return Certificate(g) == Certificate(h)
+}
+
+// AutomorphismGenerators returns a set of permutations of the vertices of a
+// graph that generates its automorphism group. The set is empty if the only
+// automorphism is the identity.
+func AutomorphismGenerators(g Graph) []graph.Permutation {
+	generators := canonize(graph.MatrixOf(g), make([]int, g.Order())).generators
+	permutations := make([]graph.Permutation, len(generators))
+	for i, gamma := range generators {
+		permutations[i] = graph.Permutation(gamma)
+	}
+	return permutations
+}
+
+// Orbits returns the orbits of the automorphism group of a graph, where
+// orbits[v] is the smallest vertex in the orbit of v.
+func Orbits(g Graph) []int {
+	n := g.Order()
+	parent := orbitForest(n, canonize(graph.MatrixOf(g), make([]int, n)).generators)
+	orbits := make([]int, n)
+	for v := range orbits {
+		orbits[v] = find(parent, v)
+	}
+	return orbits
+}
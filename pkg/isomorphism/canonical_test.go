@@ -0,0 +1,230 @@
+package isomorphism
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns a random matrix of order n where every entry outside the diagonal is
+// 1 with probability p. If symmetric is true, the matrix is symmetric.
+func randomMatrix(r *rand.Rand, n int, p float64, symmetric bool) graph.AdjacencyMatrix {
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = make([]byte, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && (!symmetric || i < j) && r.Float64() < p {
+				a[i][j] = 1
+				if symmetric {
+					a[j][i] = 1
+				}
+			}
+		}
+	}
+	return a
+}
+
+// Returns the matrix relabelled by a permutation, where vertex v becomes p[v].
+func permuteMatrix(a graph.AdjacencyMatrix, p []int) graph.AdjacencyMatrix {
+	b := make([][]byte, len(a))
+	for i := range b {
+		b[i] = make([]byte, len(a))
+	}
+	for i := range a {
+		for j := range a {
+			b[p[i]][p[j]] = a[i][j]
+		}
+	}
+	return b
+}
+
+// Returns the matrix of the cycle of order n, or of the disjoint union of
+// cycles of the given orders.
+func cyclesMatrix(orders ...int) graph.AdjacencyMatrix {
+	n := 0
+	for _, k := range orders {
+		n += k
+	}
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = make([]byte, n)
+	}
+	start := 0
+	for _, k := range orders {
+		for i := 0; i < k; i++ {
+			u, v := start+i, start+(i+1)%k
+			a[u][v], a[v][u] = 1, 1
+		}
+		start += k
+	}
+	return a
+}
+
+// Returns the matrix of the Petersen graph.
+func petersenMatrix() graph.AdjacencyMatrix {
+	a := make([][]byte, 10)
+	for i := range a {
+		a[i] = make([]byte, 10)
+	}
+	for i := 0; i < 5; i++ {
+		for _, e := range [][2]int{{i, (i + 1) % 5}, {i, i + 5}, {i + 5, (i+2)%5 + 5}} {
+			a[e[0]][e[1]], a[e[1]][e[0]] = 1, 1
+		}
+	}
+	return a
+}
+
+// Returns the order of the group generated by some permutations of n points.
+func groupOrder(n int, generators []graph.Permutation) int {
+	identity := make([]byte, n)
+	for i := range identity {
+		identity[i] = byte(i)
+	}
+	seen := map[string]bool{string(identity): true}
+	queue := [][]byte{identity}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		for _, gamma := range generators {
+			q := make([]byte, n)
+			for i := range q {
+				q[i] = byte(gamma[p[i]])
+			}
+			if !seen[string(q)] {
+				seen[string(q)] = true
+				queue = append(queue, q)
+			}
+		}
+	}
+	return len(seen)
+}
+
+// Returns the number of automorphisms of a matrix by brute force.
+func countAutomorphisms(a graph.AdjacencyMatrix) int {
+	n := len(a)
+	p := make([]int, n)
+	used := make([]bool, n)
+	count := 0
+	var extend func(i int)
+	extend = func(i int) {
+		if i == n {
+			count++
+			return
+		}
+		for v := 0; v < n; v++ {
+			if used[v] {
+				continue
+			}
+			ok := a[i][i] == a[v][v]
+			for j := 0; j < i && ok; j++ {
+				ok = a[i][j] == a[v][p[j]] && a[j][i] == a[p[j]][v]
+			}
+			if ok {
+				used[v], p[i] = true, v
+				extend(i + 1)
+				used[v] = false
+			}
+		}
+	}
+	extend(0)
+	return count
+}
+
+// TestCertificateInvariance checks that relabelling a graph or a digraph does
+// not change its certificate nor its canonical form.
+func TestCertificateInvariance(t *testing.T) {
+	r := rand.New(rand.NewSource(33))
+	for k := 0; k < 200; k++ {
+		n := r.Intn(12)
+		a := randomMatrix(r, n, r.Float64(), k%2 == 0)
+		g := graph.NewFromMatrix(a)
+		h, _ := graph.RandomRelabel(g, r)
+		b, _ := h.Matrix()
+		if Certificate(g) != Certificate(h) {
+			t.Errorf("Expected equal certificates for %v and %v", a, b)
+		}
+		if !sliceutils.EqualByteMatrix(CanonicalForm(g), CanonicalForm(h)) {
+			t.Errorf("Expected equal canonical forms for %v and %v", a, b)
+		}
+		labels := CanonicalLabeling(g)
+		if !sliceutils.EqualByteMatrix(permuteMatrix(a, labels), CanonicalForm(g)) {
+			t.Errorf("Canonical labelling of %v does not give its canonical form", a)
+		}
+	}
+}
+
+// TestAreIsomorphic checks some pairs of non-isomorphic graphs with the same
+// degree sequence, and graphs given by lists.
+func TestAreIsomorphic(t *testing.T) {
+	pairs := [][2]graph.AdjacencyMatrix{
+		{cyclesMatrix(6), cyclesMatrix(3, 3)},
+		{cyclesMatrix(10), petersenMatrix()},
+		{cyclesMatrix(4, 4), cyclesMatrix(5, 3)},
+	}
+	for _, pair := range pairs {
+		g, h := graph.NewFromMatrix(pair[0]), graph.NewFromMatrix(pair[1])
+		if AreIsomorphic(g, h) {
+			t.Errorf("Expected %v and %v not to be isomorphic", pair[0], pair[1])
+		}
+	}
+	g := graph.NewFromList([][]int{{1}, {0, 2}, {1}})
+	h := graph.NewFromMatrix([][]byte{{0, 1, 1}, {1, 0, 0}, {1, 0, 0}})
+	if !AreIsomorphic(g, h) {
+		t.Errorf("Expected two paths of order 3 to be isomorphic")
+	}
+	d := graph.NewDigraphFromMatrix([][]byte{{0, 1, 0}, {0, 0, 1}, {0, 0, 0}})
+	e := graph.NewDigraphFromMatrix([][]byte{{0, 1, 1}, {0, 0, 0}, {0, 0, 0}})
+	if AreIsomorphic(d, e) {
+		t.Errorf("Expected a directed path and an out-star not to be isomorphic")
+	}
+}
+
+// TestColouredCertificate checks that colours are respected.
+func TestColouredCertificate(t *testing.T) {
+	g := graph.NewFromMatrix(cyclesMatrix(4))
+	a, _ := ColouredCertificate(g, []int{1, 1, 2, 2})
+	b, _ := ColouredCertificate(g, []int{2, 1, 1, 2})
+	c, _ := ColouredCertificate(g, []int{1, 2, 1, 2})
+	d, _ := ColouredCertificate(g, []int{3, 3, 2, 2})
+	if a != b {
+		t.Errorf("Expected equal certificates for colourings of adjacent pairs")
+	}
+	if a == c || a == d {
+		t.Errorf("Expected different certificates for different colourings")
+	}
+	if _, err := ColouredCertificate(g, []int{1}); err != InvalidColouring {
+		t.Errorf("Expected %v, got %v", InvalidColouring, err)
+	}
+}
+
+// TestAutomorphisms checks orbits and the order of the automorphism group
+// generated by the automorphisms found during the search.
+func TestAutomorphisms(t *testing.T) {
+	petersen := graph.NewFromMatrix(petersenMatrix())
+	if order := groupOrder(10, AutomorphismGenerators(petersen)); order != 120 {
+		t.Errorf("Expected 120 automorphisms of the Petersen graph, got %v", order)
+	}
+	for _, o := range Orbits(petersen) {
+		if o != 0 {
+			t.Errorf("Expected the Petersen graph to be vertex-transitive")
+		}
+	}
+	path := graph.NewFromList([][]int{{1}, {0, 2}, {1, 3}, {2}})
+	if orbits := Orbits(path); !sliceutils.EqualIntSlice(orbits, []int{0, 1, 1, 0}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 1, 0}, orbits)
+	}
+	r := rand.New(rand.NewSource(33))
+	for k := 0; k < 100; k++ {
+		n := r.Intn(8)
+		a := randomMatrix(r, n, r.Float64(), k%3 != 0)
+		expected := countAutomorphisms(a)
+		got := groupOrder(n, AutomorphismGenerators(graph.NewFromMatrix(a)))
+		if got != expected {
+			t.Errorf("Expected %v automorphisms of %v, got %v", expected, a, got)
+		}
+	}
+}
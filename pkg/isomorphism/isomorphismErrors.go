@@ -0,0 +1,9 @@
+package isomorphism
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+var (
+	InvalidColouring = graph.GraphError("Colouring does not assign a colour to every vertex")
+)
@@ -0,0 +1,268 @@
+package isomorphism
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// An embedding of a pattern into a host is an injective map from the vertices
+// of the pattern to the vertices of the host, given as a slice where
+// embedding[v] is the image of v. It is a subgraph embedding if every arc of
+// the pattern is mapped to an arc of the host, with at least the same
+// multiplicity, and an induced subgraph embedding if the entries of the
+// adjacency matrices agree on every pair of vertices of the pattern. The
+// functions work on the adjacency matrices, so they handle graphs and digraphs
+// alike, as long as the pattern and the host are of the same kind.
+
+// The state of the search for embeddings, in the manner of VF2: the vertices of
+// the pattern are mapped in a fixed order where every vertex is adjacent to an
+// earlier one whenever possible, and each partial embedding is extended with
+// the host vertices that keep it consistent.
+type subgraphSearch struct {
+	pattern, host graph.AdjacencyMatrix
+	induced       bool
+
+	// The order in which the vertices of the pattern are mapped, and for each
+	// of them an earlier neighbour in that order, or -1.
+	order  []int
+	parent []int
+
+	patternDegrees, hostDegrees []int
+	embedding                   []int
+	used                        []bool
+}
+
+// Returns the number of arcs at every vertex of a matrix, in both directions.
+func totalDegrees(a graph.AdjacencyMatrix) []int {
+	d := make([]int, len(a))
+	for i := range a {
+		for j := range a {
+			if a[i][j] != 0 {
+				d[i]++
+			}
+			if a[j][i] != 0 {
+				d[i]++
+			}
+		}
+	}
+	return d
+}
+
+// Initializes the search, ordering the vertices of the pattern so that each
+// one has as many neighbours among the previous ones as possible, preferring
+// vertices of high degree.
+func newSubgraphSearch(pattern, host graph.AdjacencyMatrix, induced bool) *subgraphSearch {
+	n := len(pattern)
+	s := &subgraphSearch{
+		pattern:        pattern,
+		host:           host,
+		induced:        induced,
+		patternDegrees: totalDegrees(pattern),
+		hostDegrees:    totalDegrees(host),
+		embedding:      make([]int, n),
+		used:           make([]bool, len(host)),
+	}
+	ordered := make([]bool, n)
+	connections := make([]int, n)
+	for len(s.order) < n {
+		v := -1
+		for w := 0; w < n; w++ {
+			if ordered[w] {
+				continue
+			}
+			if v == -1 || connections[w] > connections[v] ||
+				(connections[w] == connections[v] && s.patternDegrees[w] > s.patternDegrees[v]) {
+				v = w
+			}
+		}
+		p := -1
+		for _, w := range s.order {
+			if pattern[v][w] != 0 || pattern[w][v] != 0 {
+				p = w
+				break
+			}
+		}
+		s.order = append(s.order, v)
+		s.parent = append(s.parent, p)
+		ordered[v] = true
+		for w := 0; w < n; w++ {
+			if pattern[v][w] != 0 || pattern[w][v] != 0 {
+				connections[w]++
+			}
+		}
+	}
+	return s
+}
+
+// Returns whether an entry of the pattern is compatible with an entry of the
+// host.
+func (s *subgraphSearch) compatible(p, h byte) bool {
+	if s.induced {
+		return p == h
+	}
+	return p <= h
+}
+
+// Returns whether the i-th vertex in the order can be mapped to x.
+func (s *subgraphSearch) feasible(i, x int) bool {
+	v := s.order[i]
+	if s.used[x] || s.hostDegrees[x] < s.patternDegrees[v] ||
+		!s.compatible(s.pattern[v][v], s.host[x][x]) {
+		return false
+	}
+	for _, w := range s.order[:i] {
+		y := s.embedding[w]
+		if !s.compatible(s.pattern[v][w], s.host[x][y]) ||
+			!s.compatible(s.pattern[w][v], s.host[y][x]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Extends the partial embedding of the first i vertices in the order, calling
+// f with every complete embedding. It returns false if f asked to stop.
+func (s *subgraphSearch) extend(i int, f func([]int) bool) bool {
+	if i == len(s.order) {
+		embedding := make([]int, len(s.embedding))
+		copy(embedding, s.embedding)
+		return f(embedding)
+	}
+	v := s.order[i]
+	for x := range s.host {
+		// A vertex with an earlier neighbour must be mapped next to its image.
+		if p := s.parent[i]; p != -1 && s.host[x][s.embedding[p]] == 0 &&
+			s.host[s.embedding[p]][x] == 0 {
+			continue
+		}
+		if !s.feasible(i, x) {
+			continue
+		}
+		s.embedding[v] = x
+		s.used[x] = true
+		next := s.extend(i+1, f)
+		s.used[x] = false
+		if !next {
+			return false
+		}
+	}
+	return true
+}
+
+// Calls f with every embedding of a pattern into a host, until f returns false.
+func embeddings(pattern, host Graph, induced bool, f func([]int) bool) {
+	p, h := graph.MatrixOf(pattern), graph.MatrixOf(host)
+	if len(p) > len(h) {
+		return
+	}
+	newSubgraphSearch(p, h, induced).extend(0, f)
+}
+
+// SubgraphEmbeddings calls f with every subgraph embedding of a pattern into a
+// host.
+func SubgraphEmbeddings(pattern, host Graph, f func([]int)) {
+	embeddings(pattern, host, false, func(e []int) bool {
+		f(e)
+		return true
+	})
+}
+
+// InducedSubgraphEmbeddings calls f with every induced subgraph embedding of a
+// pattern into a host.
+func InducedSubgraphEmbeddings(pattern, host Graph, f func([]int)) {
+	embeddings(pattern, host, true, func(e []int) bool {
+		f(e)
+		return true
+	})
+}
+
+// Returns an embedding of a pattern into a host, or nil if there is none.
+func findEmbedding(pattern, host Graph, induced bool) []int {
+	var found []int
+	embeddings(pattern, host, induced, func(e []int) bool {
+		found = e
+		return false
+	})
+	return found
+}
+
+// FindSubgraph returns a subgraph embedding of a pattern into a host, or nil if
+// the host has no subgraph isomorphic to the pattern.
+func FindSubgraph(pattern, host Graph) []int {
+	return findEmbedding(pattern, host, false)
+}
+
+// FindInducedSubgraph returns an induced subgraph embedding of a pattern into a
+// host, or nil if the host has no induced subgraph isomorphic to the pattern.
+func FindInducedSubgraph(pattern, host Graph) []int {
+	return findEmbedding(pattern, host, true)
+}
+
+// IsSubgraph returns whether a host has a subgraph isomorphic to a pattern.
+func IsSubgraph(pattern, host Graph) bool {
+	return FindSubgraph(pattern, host) != nil
+}
+
+// IsInducedSubgraph returns whether a host has an induced subgraph isomorphic
+// to a pattern.
+func IsInducedSubgraph(pattern, host Graph) bool {
+	return FindInducedSubgraph(pattern, host) != nil
+}
+
+// Returns the number of embeddings of a pattern into a host.
+func countEmbeddings(pattern, host Graph, induced bool) int {
+	count := 0
+	embeddings(pattern, host, induced, func([]int) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// CountSubgraphEmbeddings returns the number of subgraph embeddings of a
+// pattern into a host.
+func CountSubgraphEmbeddings(pattern, host Graph) int {
+	return countEmbeddings(pattern, host, false)
+}
+
+// CountInducedSubgraphEmbeddings returns the number of induced subgraph
+// embeddings of a pattern into a host.
+func CountInducedSubgraphEmbeddings(pattern, host Graph) int {
+	return countEmbeddings(pattern, host, true)
+}
+
+// CountSubgraphs returns the number of subgraphs of a host isomorphic to a
+// pattern, that is, the number of subgraph embeddings divided by the number of
+// automorphisms of the pattern. For instance, it counts the triangles of a
+// graph when the pattern is a triangle.
+func CountSubgraphs(pattern, host Graph) int {
+	return CountSubgraphEmbeddings(pattern, host) / countEmbeddings(pattern, pattern, true)
+}
+
+// CountInducedSubgraphs returns the number of sets of vertices of a host that
+// induce a subgraph isomorphic to a pattern.
+func CountInducedSubgraphs(pattern, host Graph) int {
+	return CountInducedSubgraphEmbeddings(pattern, host) / countEmbeddings(pattern, pattern, true)
+}
+
+// IsFree returns whether a graph has no subgraph isomorphic to any of the
+// forbidden graphs, or no induced subgraph if induced is true. For instance, a
+// graph is claw-free if it has no induced subgraph isomorphic to K(1,3).
+func IsFree(g Graph, forbidden []Graph, induced bool) bool {
+	for _, h := range forbidden {
+		if findEmbedding(h, g, induced) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// FreeFilter returns a function that calls f only with the graphs that are
+// free of the forbidden graphs, in the sense of IsFree. It is meant to filter
+// the graphs produced by an enumeration.
+func FreeFilter(forbidden []Graph, induced bool, f func(*graph.StaticGraph)) func(*graph.StaticGraph) {
+	return func(g *graph.StaticGraph) {
+		if IsFree(g, forbidden, induced) {
+			f(g)
+		}
+	}
+}
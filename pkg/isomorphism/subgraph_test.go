@@ -0,0 +1,149 @@
+package isomorphism
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// Returns the number of embeddings of a pattern into a host by trying every
+// injective map.
+func bruteForceEmbeddings(p, h graph.AdjacencyMatrix, induced bool) int {
+	image := make([]int, len(p))
+	used := make([]bool, len(h))
+	var count func(v int) int
+	count = func(v int) int {
+		if v == len(p) {
+			for i := range p {
+				for j := range p {
+					x, y := p[i][j], h[image[i]][image[j]]
+					if (induced && x != y) || (!induced && x > y) {
+						return 0
+					}
+				}
+			}
+			return 1
+		}
+		total := 0
+		for x := range h {
+			if !used[x] {
+				used[x] = true
+				image[v] = x
+				total += count(v + 1)
+				used[x] = false
+			}
+		}
+		return total
+	}
+	return count(0)
+}
+
+// TestEmbeddingsRandom compares the number of embeddings of random patterns
+// into random hosts with a brute force count, for graphs and digraphs, and
+// checks that every reported embedding is valid.
+func TestEmbeddingsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(44))
+	for k := 0; k < 200; k++ {
+		symmetric := k%2 == 0
+		p := randomMatrix(r, 1+r.Intn(4), r.Float64(), symmetric)
+		h := randomMatrix(r, 1+r.Intn(7), r.Float64(), symmetric)
+		var pattern, host Graph
+		if symmetric {
+			pattern, host = graph.NewFromMatrix(p), graph.NewFromMatrix(h)
+		} else {
+			pattern, host = graph.NewDigraphFromMatrix(p), graph.NewDigraphFromMatrix(h)
+		}
+		for _, induced := range []bool{false, true} {
+			count := 0
+			embeddings(pattern, host, induced, func(e []int) bool {
+				count++
+				for i := range p {
+					for j := range p {
+						x, y := p[i][j], h[e[i]][e[j]]
+						if (induced && x != y) || (!induced && x > y) {
+							t.Errorf("Invalid embedding %v", e)
+							return false
+						}
+					}
+				}
+				return true
+			})
+			if expected := bruteForceEmbeddings(p, h, induced); count != expected {
+				t.Errorf("Expected %v embeddings, got %v", expected, count)
+			}
+			if found := findEmbedding(pattern, host, induced) != nil; found != (count > 0) {
+				t.Errorf("Expected %v, got %v", count > 0, found)
+			}
+		}
+	}
+}
+
+// TestCountSubgraphs counts triangles, cycles and claws in complete graphs and
+// in the Petersen graph.
+func TestCountSubgraphs(t *testing.T) {
+	triangle := graph.NewFromMatrix(cyclesMatrix(3))
+	square := graph.NewFromMatrix(cyclesMatrix(4))
+	pentagon := graph.NewFromMatrix(cyclesMatrix(5))
+	claw := graph.NewFromList([][]int{{1, 2, 3}, {0}, {0}, {0}})
+	k5 := graph.NewFromMatrix(randomMatrix(rand.New(rand.NewSource(44)), 5, 1, true))
+	petersen := graph.NewFromMatrix(petersenMatrix())
+	tests := []struct {
+		count, expected int
+	}{
+		{CountSubgraphs(triangle, k5), 10},
+		{CountSubgraphs(square, k5), 15},
+		{CountInducedSubgraphs(square, k5), 0},
+		{CountSubgraphs(triangle, petersen), 0},
+		{CountSubgraphs(pentagon, petersen), 12},
+		{CountInducedSubgraphs(pentagon, petersen), 12},
+		{CountInducedSubgraphs(claw, petersen), 10},
+		{CountSubgraphEmbeddings(triangle, k5), 60},
+		{CountInducedSubgraphEmbeddings(petersen, petersen), 120},
+	}
+	for i, test := range tests {
+		if test.count != test.expected {
+			t.Errorf("Case %v: expected %v, got %v", i, test.expected, test.count)
+		}
+	}
+	if !IsSubgraph(claw, petersen) || IsInducedSubgraph(triangle, petersen) {
+		t.Errorf("Expected the Petersen graph to contain a claw and no triangle")
+	}
+	if e := FindInducedSubgraph(square, graph.NewFromMatrix(cyclesMatrix(4, 4))); e == nil {
+		t.Errorf("Expected an induced square in two disjoint squares")
+	}
+}
+
+// TestFreeFilter checks that the filter keeps only the triangle-free graphs and
+// the claw-free graphs.
+func TestFreeFilter(t *testing.T) {
+	triangle := graph.NewFromMatrix(cyclesMatrix(3))
+	claw := graph.NewFromList([][]int{{1, 2, 3}, {0}, {0}, {0}})
+	graphs := []*graph.StaticGraph{
+		graph.NewFromMatrix(petersenMatrix()),
+		graph.NewFromMatrix(cyclesMatrix(3, 4)),
+		graph.NewFromMatrix(cyclesMatrix(7)),
+	}
+	tests := []struct {
+		forbidden []Graph
+		induced   bool
+		expected  int
+	}{
+		{[]Graph{triangle}, false, 2},
+		{[]Graph{claw}, true, 2},
+		{[]Graph{triangle, claw}, true, 1},
+		{[]Graph{claw}, false, 2},
+	}
+	for _, test := range tests {
+		count := 0
+		filter := FreeFilter(test.forbidden, test.induced, func(*graph.StaticGraph) {
+			count++
+		})
+		for _, g := range graphs {
+			filter(g)
+		}
+		if count != test.expected {
+			t.Errorf("Expected %v graphs, got %v", test.expected, count)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package operations
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+var (
+	InvalidVertex  = graph.GraphError("Vertex does not belong to the graph")
+	RepeatedVertex = graph.GraphError("Vertex appears more than once")
+	SameVertices   = graph.GraphError("Vertices are expected to be different")
+	NotAnEdge      = graph.GraphError("Vertices are not adjacent")
+	InvalidPower   = graph.GraphError("Power of a graph must be positive")
+)
@@ -0,0 +1,220 @@
+// Package operations provides operations that build new graphs and digraphs
+// from existing ones, such as products, unions and complements. The operands
+// are never modified.
+package operations
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+type Graph = graph.Graph
+type StaticGraph = graph.StaticGraph
+type StaticDigraph = graph.StaticDigraph
+
+// The binary operations are defined on adjacency matrices, where a nonzero
+// entry is an arc. The same definition gives the operation on graphs
+// (symmetric matrices) and on digraphs. In the products, the vertex (i, j) of
+// the product of a graph of order n and a graph of order m is i*m + j.
+
+// Makes an empty square matrix of order n.
+func emptyMatrix(n int) graph.AdjacencyMatrix {
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = make([]byte, n)
+	}
+	return a
+}
+
+// Makes the matrix of a product, where the entry for the arc from (i, j) to
+// (k, l) is given by a function of the vertices and the two factors.
+func productMatrix(a, b graph.AdjacencyMatrix, adjacent func(i, j, k, l int) bool) graph.AdjacencyMatrix {
+	n, m := len(a), len(b)
+	c := emptyMatrix(n * m)
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			for k := 0; k < n; k++ {
+				for l := 0; l < m; l++ {
+					if (i != k || j != l) && adjacent(i, j, k, l) {
+						c[i*m+j][k*m+l] = 1
+					}
+				}
+			}
+		}
+	}
+	return c
+}
+
+// Makes the matrix of the Cartesian product of two matrices.
+func cartesianMatrix(a, b graph.AdjacencyMatrix) graph.AdjacencyMatrix {
+	return productMatrix(a, b, func(i, j, k, l int) bool {
+		return (i == k && b[j][l] != 0) || (j == l && a[i][k] != 0)
+	})
+}
+
+// Makes the matrix of the tensor product of two matrices.
+func tensorMatrix(a, b graph.AdjacencyMatrix) graph.AdjacencyMatrix {
+	return productMatrix(a, b, func(i, j, k, l int) bool {
+		return a[i][k] != 0 && b[j][l] != 0
+	})
+}
+
+// Makes the matrix of the strong product of two matrices.
+func strongMatrix(a, b graph.AdjacencyMatrix) graph.AdjacencyMatrix {
+	return productMatrix(a, b, func(i, j, k, l int) bool {
+		return (i == k && b[j][l] != 0) || (j == l && a[i][k] != 0) ||
+			(a[i][k] != 0 && b[j][l] != 0)
+	})
+}
+
+// Makes the matrix of the lexicographic product of two matrices.
+func lexicographicMatrix(a, b graph.AdjacencyMatrix) graph.AdjacencyMatrix {
+	return productMatrix(a, b, func(i, j, k, l int) bool {
+		return a[i][k] != 0 || (i == k && b[j][l] != 0)
+	})
+}
+
+// Makes the matrix of the disjoint union of two matrices, where every vertex
+// of the first one is joined to every vertex of the second one in both
+// directions if join is true.
+func unionMatrix(a, b graph.AdjacencyMatrix, join bool) graph.AdjacencyMatrix {
+	n, m := len(a), len(b)
+	c := emptyMatrix(n + m)
+	for i := range a {
+		copy(c[i], a[i])
+	}
+	for j := range b {
+		copy(c[n+j][n:], b[j])
+	}
+	if join {
+		for i := 0; i < n; i++ {
+			for j := n; j < n+m; j++ {
+				c[i][j], c[j][i] = 1, 1
+			}
+		}
+	}
+	return c
+}
+
+// Makes the matrix of the corona of two matrices: a copy of the second one for
+// every vertex of the first one, which is joined in both directions to every
+// vertex of its copy. The copy for vertex i has vertices n + i*m, ...,
+// n + i*m + m - 1, where n and m are the orders of the matrices.
+func coronaMatrix(a, b graph.AdjacencyMatrix) graph.AdjacencyMatrix {
+	n, m := len(a), len(b)
+	c := emptyMatrix(n + n*m)
+	for i := range a {
+		copy(c[i], a[i])
+	}
+	for i := 0; i < n; i++ {
+		start := n + i*m
+		for j := range b {
+			copy(c[start+j][start:], b[j])
+			c[i][start+j], c[start+j][i] = 1, 1
+		}
+	}
+	return c
+}
+
+// CartesianProduct returns the Cartesian product of two graphs, where (i, j)
+// and (k, l) are adjacent if i = k and j is adjacent to l, or j = l and i is
+// adjacent to k. The product is modelled by an adjacency matrix.
+func CartesianProduct(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(cartesianMatrix(graph.MatrixOf(g), graph.MatrixOf(h)))
+}
+
+// CartesianProductDigraph returns the Cartesian product of two digraphs, where
+// there is an arc from (i, j) to (k, l) if i = k and there is an arc from j to
+// l, or j = l and there is an arc from i to k. The product is modelled by an
+// adjacency matrix.
+func CartesianProductDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(cartesianMatrix(graph.MatrixOf(d), graph.MatrixOf(e)))
+}
+
+// TensorProduct returns the tensor (categorical) product of two graphs, where
+// (i, j) and (k, l) are adjacent if i is adjacent to k and j is adjacent to l.
+// The product is modelled by an adjacency matrix.
+func TensorProduct(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(tensorMatrix(graph.MatrixOf(g), graph.MatrixOf(h)))
+}
+
+// TensorProductDigraph returns the tensor product of two digraphs, where there
+// is an arc from (i, j) to (k, l) if there are arcs from i to k and from j to
+// l. The product is modelled by an adjacency matrix.
+func TensorProductDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(tensorMatrix(graph.MatrixOf(d), graph.MatrixOf(e)))
+}
+
+// StrongProduct returns the strong product of two graphs, the union of their
+// Cartesian and tensor products. The product is modelled by an adjacency
+// matrix.
+func StrongProduct(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(strongMatrix(graph.MatrixOf(g), graph.MatrixOf(h)))
+}
+
+// StrongProductDigraph returns the strong product of two digraphs, the union
+// of their Cartesian and tensor products. The product is modelled by an
+// adjacency matrix.
+func StrongProductDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(strongMatrix(graph.MatrixOf(d), graph.MatrixOf(e)))
+}
+
+// LexicographicProduct returns the lexicographic product (composition) of two
+// graphs, where (i, j) and (k, l) are adjacent if i is adjacent to k, or i = k
+// and j is adjacent to l. The product is modelled by an adjacency matrix.
+func LexicographicProduct(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(lexicographicMatrix(graph.MatrixOf(g), graph.MatrixOf(h)))
+}
+
+// LexicographicProductDigraph returns the lexicographic product of two
+// digraphs, where there is an arc from (i, j) to (k, l) if there is an arc
+// from i to k, or i = k and there is an arc from j to l. The product is
+// modelled by an adjacency matrix.
+func LexicographicProductDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(lexicographicMatrix(graph.MatrixOf(d), graph.MatrixOf(e)))
+}
+
+// DisjointUnion returns the disjoint union of two graphs, where the vertices
+// of the second graph are numbered after those of the first one. The union is
+// modelled by an adjacency matrix.
+func DisjointUnion(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(unionMatrix(graph.MatrixOf(g), graph.MatrixOf(h), false))
+}
+
+// DisjointUnionDigraph returns the disjoint union of two digraphs, where the
+// vertices of the second digraph are numbered after those of the first one.
+// The union is modelled by an adjacency matrix.
+func DisjointUnionDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(unionMatrix(graph.MatrixOf(d), graph.MatrixOf(e), false))
+}
+
+// Join returns the join of two graphs, their disjoint union together with
+// every edge between a vertex of the first graph and a vertex of the second
+// one. The join is modelled by an adjacency matrix.
+func Join(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(unionMatrix(graph.MatrixOf(g), graph.MatrixOf(h), true))
+}
+
+// JoinDigraph returns the join of two digraphs, their disjoint union together
+// with the arcs in both directions between every vertex of the first digraph
+// and every vertex of the second one. The join is modelled by an adjacency
+// matrix.
+func JoinDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(unionMatrix(graph.MatrixOf(d), graph.MatrixOf(e), true))
+}
+
+// Corona returns the corona of two graphs: a copy of g together with a copy
+// of h for every vertex of g, which is joined to every vertex of its copy. If
+// g has order n and h has order m, the copy of h for vertex i consists of the
+// vertices n + i*m, ..., n + i*m + m - 1. The corona is modelled by an
+// adjacency matrix.
+func Corona(g, h Graph) *StaticGraph {
+	return graph.NewFromMatrix(coronaMatrix(graph.MatrixOf(g), graph.MatrixOf(h)))
+}
+
+// CoronaDigraph returns the corona of two digraphs: a copy of d together with
+// a copy of e for every vertex of d, with arcs in both directions between the
+// vertex and every vertex of its copy. The vertices are numbered as in
+// Corona. The corona is modelled by an adjacency matrix.
+func CoronaDigraph(d, e *StaticDigraph) *StaticDigraph {
+	return graph.NewDigraphFromMatrix(coronaMatrix(graph.MatrixOf(d), graph.MatrixOf(e)))
+}
@@ -0,0 +1,111 @@
+package operations
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// Returns a random digraph of order n without loops, where every arc is
+// present with probability p.
+func randomDigraph(r *rand.Rand, n int, p float64) *StaticDigraph {
+	a := emptyMatrix(n)
+	for i := range a {
+		for j := range a {
+			if i != j && r.Float64() < p {
+				a[i][j] = 1
+			}
+		}
+	}
+	return graph.NewDigraphFromMatrix(a)
+}
+
+// TestProductSizes checks the order and size of the products of random graphs
+// and digraphs, given by the orders and sizes of the factors.
+func TestProductSizes(t *testing.T) {
+	r := rand.New(rand.NewSource(36))
+	for k := 0; k < 30; k++ {
+		g, _ := generators.GnpRandomGraph(r.Intn(7), r.Float64(), r)
+		h, _ := generators.GnpRandomGraph(r.Intn(7), r.Float64(), r)
+		n1, m1, n2, m2 := g.Order(), g.Size(), h.Order(), h.Size()
+		cases := []struct {
+			name        string
+			result      *StaticGraph
+			order, size int
+		}{
+			{"Cartesian product", CartesianProduct(g, h), n1 * n2, n1*m2 + n2*m1},
+			{"tensor product", TensorProduct(g, h), n1 * n2, 2 * m1 * m2},
+			{"strong product", StrongProduct(g, h), n1 * n2, n1*m2 + n2*m1 + 2*m1*m2},
+			{"lexicographic product", LexicographicProduct(g, h), n1 * n2, n1*m2 + n2*n2*m1},
+			{"disjoint union", DisjointUnion(g, h), n1 + n2, m1 + m2},
+			{"join", Join(g, h), n1 + n2, m1 + m2 + n1*n2},
+			{"corona", Corona(g, h), n1 + n1*n2, m1 + n1*m2 + n1*n2},
+		}
+		for _, c := range cases {
+			if c.result.Order() != c.order || c.result.Size() != c.size {
+				t.Errorf("Expected the %v to have order %v and size %v, got %v and %v",
+					c.name, c.order, c.size, c.result.Order(), c.result.Size())
+			}
+		}
+		d, e := randomDigraph(r, n1, r.Float64()), randomDigraph(r, n2, r.Float64())
+		a1, a2 := d.Size(), e.Size()
+		digraphCases := []struct {
+			name        string
+			result      *StaticDigraph
+			order, size int
+		}{
+			{"Cartesian product", CartesianProductDigraph(d, e), n1 * n2, n1*a2 + n2*a1},
+			{"tensor product", TensorProductDigraph(d, e), n1 * n2, a1 * a2},
+			{"strong product", StrongProductDigraph(d, e), n1 * n2, n1*a2 + n2*a1 + a1*a2},
+			{"lexicographic product", LexicographicProductDigraph(d, e), n1 * n2, n1*a2 + n2*n2*a1},
+			{"disjoint union", DisjointUnionDigraph(d, e), n1 + n2, a1 + a2},
+			{"join", JoinDigraph(d, e), n1 + n2, a1 + a2 + 2*n1*n2},
+			{"corona", CoronaDigraph(d, e), n1 + n1*n2, a1 + n1*a2 + 2*n1*n2},
+		}
+		for _, c := range digraphCases {
+			if c.result.Order() != c.order || c.result.Size() != c.size {
+				t.Errorf("Expected the directed %v to have order %v and size %v, got %v and %v",
+					c.name, c.order, c.size, c.result.Order(), c.result.Size())
+			}
+		}
+	}
+}
+
+// TestProducts checks some well-known products.
+func TestProducts(t *testing.T) {
+	k1, k2, k3 := generators.CompleteMatrixGraph(1), generators.CompleteMatrixGraph(2),
+		generators.CompleteMatrixGraph(3)
+	octahedron, _ := generators.CompleteMultipartiteMatrixGraph([]int{2, 2, 2})
+	prism, _ := generators.PrismMatrixGraph(5)
+	w5, _ := generators.WheelMatrixGraph(5)
+	w4, _ := generators.WheelMatrixGraph(4)
+	cases := []struct {
+		name             string
+		result, expected Graph
+	}{
+		{"K2 □ K2 = C4", CartesianProduct(k2, k2), generators.MatrixCycle(4)},
+		{"K2 □ C5 = prism", CartesianProduct(k2, generators.ListCycle(5)), prism},
+		{"K2 × K3 = C6", TensorProduct(k2, k3), generators.MatrixCycle(6)},
+		{"K2 ⊠ K2 = K4", StrongProduct(k2, k2), generators.CompleteMatrixGraph(4)},
+		{"K3[2K1] = K(2,2,2)", LexicographicProduct(k3, DisjointUnion(k1, k1)), octahedron},
+		{"K1 + C5 = W5", Join(k1, generators.MatrixCycle(5)), w5},
+		{"2K1 + 3K1 = K(2,3)", Join(DisjointUnion(k1, k1), DisjointUnion(k1, DisjointUnion(k1, k1))), generators.CompleteBipartiteMatrixGraph(2, 3)},
+		{"P2 ∘ K1 = P4", Corona(generators.MatrixPath(2), k1), generators.MatrixPath(4)},
+		{"K1 ∘ C4 = W4", Corona(k1, generators.MatrixCycle(4)), w4},
+	}
+	for _, c := range cases {
+		if !isomorphism.AreIsomorphic(c.result, c.expected) {
+			t.Errorf("Expected %v", c.name)
+		}
+	}
+	cycle := generators.MatrixDirectedCycle(3)
+	torus := CartesianProductDigraph(cycle, cycle)
+	for v := 0; v < torus.Order(); v++ {
+		if torus.Outdegrees()[v] != 2 || torus.Indegrees()[v] != 2 {
+			t.Errorf("Expected the product of directed cycles to be 2-diregular")
+		}
+	}
+}
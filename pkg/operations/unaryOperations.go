@@ -0,0 +1,321 @@
+package operations
+
+import (
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/graph"
+)
+
+// The unary operations consider graphs as simple: loops are ignored, and
+// multiple edges count as a single edge. Every operation returns a new graph
+// modelled by an adjacency matrix, leaving its operand untouched.
+
+// Returns the edges of a matrix, as pairs (i, j) with i < j in lexicographic
+// order.
+func matrixEdges(a graph.AdjacencyMatrix) [][2]int {
+	var edges [][2]int
+	for i := range a {
+		for j := i + 1; j < len(a); j++ {
+			if a[i][j] != 0 {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	return edges
+}
+
+// Returns an error if u and v are not different vertices of a matrix.
+func checkVertexPair(a graph.AdjacencyMatrix, u, v int) error {
+	if u < 0 || v < 0 || u >= len(a) || v >= len(a) {
+		return InvalidVertex
+	} else if u == v {
+		return SameVertices
+	}
+	return nil
+}
+
+// Complement returns the complement of a graph, where two different vertices
+// are adjacent if and only if they are not adjacent in the graph.
+func Complement(g Graph) *StaticGraph {
+	a := graph.MatrixOf(g)
+	c := emptyMatrix(len(a))
+	for i := range a {
+		for j := range a {
+			if i != j && a[i][j] == 0 {
+				c[i][j] = 1
+			}
+		}
+	}
+	return graph.NewFromMatrix(c)
+}
+
+// LineGraph returns the line graph of a graph, whose vertices are the edges of
+// the graph, two of them adjacent if they share an endpoint. The edges are
+// returned as well, as pairs (i, j) with i < j in lexicographic order, so
+// vertex k of the line graph is edges[k].
+func LineGraph(g Graph) (*StaticGraph, [][2]int) {
+	edges := matrixEdges(graph.MatrixOf(g))
+	c := emptyMatrix(len(edges))
+	for k, e := range edges {
+		for l := k + 1; l < len(edges); l++ {
+			f := edges[l]
+			if e[0] == f[0] || e[0] == f[1] || e[1] == f[0] || e[1] == f[1] {
+				c[k][l], c[l][k] = 1, 1
+			}
+		}
+	}
+	return graph.NewFromMatrix(c), edges
+}
+
+// TotalGraph returns the total graph of a graph, whose vertices are the
+// vertices and the edges of the graph, two of them adjacent if they are
+// adjacent or incident in the graph. The first n vertices are those of the
+// graph, and vertex n + k is edges[k], where the edges are returned as pairs
+// (i, j) with i < j in lexicographic order.
+func TotalGraph(g Graph) (*StaticGraph, [][2]int) {
+	a := graph.MatrixOf(g)
+	n := len(a)
+	line, edges := LineGraph(g)
+	l, _ := line.Matrix()
+	c := emptyMatrix(n + len(edges))
+	for i := range a {
+		for j := range a {
+			if i != j && a[i][j] != 0 {
+				c[i][j] = 1
+			}
+		}
+	}
+	for k, e := range edges {
+		copy(c[n+k][n:], l[k])
+		for _, v := range e {
+			c[v][n+k], c[n+k][v] = 1, 1
+		}
+	}
+	return graph.NewFromMatrix(c), edges
+}
+
+// Power returns the k-th power of a graph, where two different vertices are
+// adjacent if their distance in the graph is at most k. If k is not positive,
+// an error is returned.
+func Power(g Graph, k int) (*StaticGraph, error) {
+	if k < 1 {
+		return nil, InvalidPower
+	}
+	a := graph.MatrixOf(g)
+	c := emptyMatrix(len(a))
+	for s := range a {
+		// Breadth-first search up to distance k.
+		distance := make([]int, len(a))
+		for v := range distance {
+			distance[v] = -1
+		}
+		distance[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			if distance[v] == k {
+				continue
+			}
+			for w := range a {
+				if a[v][w] != 0 && distance[w] == -1 {
+					distance[w] = distance[v] + 1
+					c[s][w] = 1
+					queue = append(queue, w)
+				}
+			}
+		}
+	}
+	return graph.NewFromMatrix(c), nil
+}
+
+// Square returns the square of a graph, where two different vertices are
+// adjacent if their distance in the graph is at most 2.
+func Square(g Graph) *StaticGraph {
+	square, _ := Power(g, 2)
+	return square
+}
+
+// InducedSubgraph returns the subgraph induced by a set of vertices, where
+// vertex i of the subgraph is vertices[i]. If a vertex does not belong to the
+// graph or is repeated, an error is returned.
+func InducedSubgraph(g Graph, vertices []int) (*StaticGraph, error) {
+	a := graph.MatrixOf(g)
+	used := make([]bool, len(a))
+	for _, v := range vertices {
+		if v < 0 || v >= len(a) {
+			return nil, InvalidVertex
+		} else if used[v] {
+			return nil, RepeatedVertex
+		}
+		used[v] = true
+	}
+	c := emptyMatrix(len(vertices))
+	for i, v := range vertices {
+		for j, w := range vertices {
+			if i != j && a[v][w] != 0 {
+				c[i][j] = 1
+			}
+		}
+	}
+	return graph.NewFromMatrix(c), nil
+}
+
+// DeleteEdge returns the graph obtained by deleting the edge between u and v.
+// If u and v are not adjacent, an error is returned.
+func DeleteEdge(g Graph, u, v int) (*StaticGraph, error) {
+	a := graph.MatrixOf(g)
+	if err := checkVertexPair(a, u, v); err != nil {
+		return nil, err
+	} else if a[u][v] == 0 {
+		return nil, NotAnEdge
+	}
+	c := emptyMatrix(len(a))
+	for i := range a {
+		for j := range a {
+			if i != j && a[i][j] != 0 {
+				c[i][j] = 1
+			}
+		}
+	}
+	c[u][v], c[v][u] = 0, 0
+	return graph.NewFromMatrix(c), nil
+}
+
+// Makes the matrix obtained by identifying two vertices, which becomes the
+// smaller of the two, while the vertices after the greater one are shifted.
+func identifyMatrix(a graph.AdjacencyMatrix, u, v int) graph.AdjacencyMatrix {
+	if u > v {
+		u, v = v, u
+	}
+	index := func(w int) int {
+		if w == v {
+			return u
+		} else if w > v {
+			return w - 1
+		}
+		return w
+	}
+	c := emptyMatrix(len(a) - 1)
+	for i := range a {
+		for j := range a {
+			if x, y := index(i), index(j); x != y && a[i][j] != 0 {
+				c[x][y] = 1
+			}
+		}
+	}
+	return c
+}
+
+// IdentifyVertices returns the graph obtained by identifying two different
+// vertices u and v into a single vertex adjacent to the neighbours of both.
+// The new vertex is the smaller of u and v, and the vertices after the greater
+// one are shifted down by one. If the vertices are not valid, an error is
+// returned.
+func IdentifyVertices(g Graph, u, v int) (*StaticGraph, error) {
+	a := graph.MatrixOf(g)
+	if err := checkVertexPair(a, u, v); err != nil {
+		return nil, err
+	}
+	return graph.NewFromMatrix(identifyMatrix(a, u, v)), nil
+}
+
+// ContractEdge returns the graph obtained by contracting the edge between u
+// and v, which are identified as in IdentifyVertices. If u and v are not
+// adjacent, an error is returned.
+func ContractEdge(g Graph, u, v int) (*StaticGraph, error) {
+	a := graph.MatrixOf(g)
+	if err := checkVertexPair(a, u, v); err != nil {
+		return nil, err
+	} else if a[u][v] == 0 {
+		return nil, NotAnEdge
+	}
+	return graph.NewFromMatrix(identifyMatrix(a, u, v)), nil
+}
+
+// SubdivideEdge returns the graph obtained by replacing the edge between u and
+// v with a path of length 2 through a new vertex, which is the last one. If u
+// and v are not adjacent, an error is returned.
+func SubdivideEdge(g Graph, u, v int) (*StaticGraph, error) {
+	a := graph.MatrixOf(g)
+	if err := checkVertexPair(a, u, v); err != nil {
+		return nil, err
+	} else if a[u][v] == 0 {
+		return nil, NotAnEdge
+	}
+	n := len(a)
+	c := emptyMatrix(n + 1)
+	for i := range a {
+		for j := range a {
+			if i != j && a[i][j] != 0 {
+				c[i][j] = 1
+			}
+		}
+	}
+	c[u][v], c[v][u] = 0, 0
+	c[u][n], c[n][u], c[v][n], c[n][v] = 1, 1, 1, 1
+	return graph.NewFromMatrix(c), nil
+}
+
+// Subdivision returns the graph obtained by subdividing every edge of a graph
+// once. The first n vertices are those of the graph, and vertex n + k
+// subdivides edges[k], where the edges are returned as pairs (i, j) with i < j
+// in lexicographic order.
+func Subdivision(g Graph) (*StaticGraph, [][2]int) {
+	a := graph.MatrixOf(g)
+	n := len(a)
+	edges := matrixEdges(a)
+	c := emptyMatrix(n + len(edges))
+	for k, e := range edges {
+		for _, v := range e {
+			c[v][n+k], c[n+k][v] = 1, 1
+		}
+	}
+	return graph.NewFromMatrix(c), edges
+}
+
+// Mycielskian returns the Mycielskian of a graph of order n, which has the
+// same clique number and a chromatic number greater by one. Its vertices are
+// the vertices v of the graph, a copy n + v of each of them adjacent to the
+// neighbours of v, and a vertex 2n adjacent to every copy.
+func Mycielskian(g Graph) *StaticGraph {
+	a := graph.MatrixOf(g)
+	n := len(a)
+	c := emptyMatrix(2*n + 1)
+	for i := range a {
+		for j := range a {
+			if i != j && a[i][j] != 0 {
+				c[i][j] = 1
+				c[n+i][j], c[j][n+i] = 1, 1
+			}
+		}
+		c[n+i][2*n], c[2*n][n+i] = 1, 1
+	}
+	return graph.NewFromMatrix(c)
+}
+
+// Converse returns the converse of a digraph, obtained by reversing every arc.
+func Converse(d *StaticDigraph) *StaticDigraph {
+	a := graph.MatrixOf(d)
+	c := emptyMatrix(len(a))
+	for i := range a {
+		for j := range a {
+			c[j][i] = a[i][j]
+		}
+	}
+	return graph.NewDigraphFromMatrix(c)
+}
+
+// UnderlyingGraph returns the underlying graph of a digraph, where two
+// different vertices are adjacent if there is an arc between them in any
+// direction.
+func UnderlyingGraph(d *StaticDigraph) *StaticGraph {
+	a := graph.MatrixOf(d)
+	c := emptyMatrix(len(a))
+	for i := range a {
+		for j := range a {
+			if i != j && (a[i][j] != 0 || a[j][i] != 0) {
+				c[i][j] = 1
+			}
+		}
+	}
+	return graph.NewFromMatrix(c)
+}
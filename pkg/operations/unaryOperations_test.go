@@ -0,0 +1,140 @@
+package operations
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ciencias-graph-theory/graph-theory-tools/internal/sliceutils"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/generators"
+	"github.com/ciencias-graph-theory/graph-theory-tools/pkg/isomorphism"
+)
+
+// TestUnaryOperationSizes checks the order and size of the results of the
+// unary operations on random graphs, and that the operands are not modified.
+func TestUnaryOperationSizes(t *testing.T) {
+	r := rand.New(rand.NewSource(37))
+	for k := 0; k < 30; k++ {
+		g, _ := generators.GnpRandomGraph(1+r.Intn(9), r.Float64(), r)
+		a, _ := g.Matrix()
+		original := make([][]byte, len(a))
+		for i := range a {
+			original[i] = append([]byte(nil), a[i]...)
+		}
+		n, m := g.Order(), g.Size()
+		pairs := 0
+		for _, d := range g.DegreeSequence() {
+			pairs += d * (d - 1) / 2
+		}
+		line, _ := LineGraph(g)
+		total, _ := TotalGraph(g)
+		subdivision, _ := Subdivision(g)
+		cases := []struct {
+			name        string
+			result      *StaticGraph
+			order, size int
+		}{
+			{"complement", Complement(g), n, n*(n-1)/2 - m},
+			{"line graph", line, m, pairs},
+			{"total graph", total, n + m, 3*m + pairs},
+			{"subdivision", subdivision, n + m, 2 * m},
+			{"Mycielskian", Mycielskian(g), 2*n + 1, 3*m + n},
+		}
+		for _, c := range cases {
+			if c.result.Order() != c.order || c.result.Size() != c.size {
+				t.Errorf("Expected the %v to have order %v and size %v, got %v and %v",
+					c.name, c.order, c.size, c.result.Order(), c.result.Size())
+			}
+		}
+		edges := matrixEdges(a)
+		if len(edges) > 0 {
+			e := edges[r.Intn(len(edges))]
+			deleted, _ := DeleteEdge(g, e[0], e[1])
+			subdivided, _ := SubdivideEdge(g, e[0], e[1])
+			contracted, _ := ContractEdge(g, e[0], e[1])
+			common := 0
+			for v := range a {
+				if a[e[0]][v] != 0 && a[e[1]][v] != 0 {
+					common++
+				}
+			}
+			if deleted.Size() != m-1 || subdivided.Size() != m+1 || subdivided.Order() != n+1 {
+				t.Errorf("Unexpected size after deleting or subdividing %v in %v", e, a)
+			}
+			if contracted.Order() != n-1 || contracted.Size() != m-1-common {
+				t.Errorf("Unexpected size after contracting %v in %v", e, a)
+			}
+		}
+		if !sliceutils.EqualByteMatrix(a, original) {
+			t.Errorf("Operand was modified")
+		}
+	}
+}
+
+// TestUnaryOperations checks some well-known results of the unary operations.
+func TestUnaryOperations(t *testing.T) {
+	c5 := generators.MatrixCycle(5)
+	octahedron, _ := generators.CompleteMultipartiteMatrixGraph([]int{2, 2, 2})
+	lineK4, _ := LineGraph(generators.CompleteMatrixGraph(4))
+	lineClaw, _ := LineGraph(generators.StarMatrixGraph(3))
+	totalK3, _ := TotalGraph(generators.CompleteMatrixGraph(3))
+	cube, _ := Power(generators.MatrixCycle(8), 3)
+	induced, _ := InducedSubgraph(generators.PetersenMatrixGraph(), []int{0, 1, 2, 3, 4})
+	contracted, _ := ContractEdge(c5, 0, 1)
+	identified, _ := IdentifyVertices(generators.MatrixCycle(4), 0, 2)
+	subdivided, _ := SubdivideEdge(generators.CompleteMatrixGraph(3), 0, 2)
+	cases := []struct {
+		name             string
+		result, expected Graph
+	}{
+		{"complement of C5 = C5", Complement(c5), c5},
+		{"L(K4) = K(2,2,2)", lineK4, octahedron},
+		{"L(K(1,3)) = K3", lineClaw, generators.CompleteMatrixGraph(3)},
+		{"T(K3) = K6 minus a perfect matching", totalK3, Complement(DisjointUnion(generators.CompleteMatrixGraph(2), DisjointUnion(generators.CompleteMatrixGraph(2), generators.CompleteMatrixGraph(2))))},
+		{"C8^3 = K8 minus a perfect matching", cube, Complement(generators.CirculantMatrixGraph(8, []int{4}))},
+		{"outer cycle of the Petersen graph = C5", induced, c5},
+		{"C5 / e = C4", contracted, generators.MatrixCycle(4)},
+		{"C4 with opposite vertices identified = P3", identified, generators.MatrixPath(3)},
+		{"K3 with a subdivided edge = C4", subdivided, generators.MatrixCycle(4)},
+		{"Mycielskian of K2 = C5", Mycielskian(generators.CompleteMatrixGraph(2)), c5},
+	}
+	for _, c := range cases {
+		if !isomorphism.AreIsomorphic(c.result, c.expected) {
+			t.Errorf("Expected %v", c.name)
+		}
+	}
+	if square := Square(generators.MatrixPath(6)); square.Size() != 9 {
+		t.Errorf("Expected the square of P6 to have size 9, got %v", square.Size())
+	}
+	grotzsch := Mycielskian(c5)
+	if grotzsch.Order() != 11 || grotzsch.Size() != 20 {
+		t.Errorf("Expected the Grötzsch graph to have order 11 and size 20")
+	}
+
+	path := generators.MatrixDirectedPath(4)
+	converse := Converse(path)
+	if !sliceutils.EqualIntSlice(converse.Indegrees(), path.Outdegrees()) {
+		t.Errorf("Expected the in-degrees of the converse to be the out-degrees")
+	}
+	if !isomorphism.AreIsomorphic(converse, path) {
+		t.Errorf("Expected the converse of a directed path to be a directed path")
+	}
+	if !isomorphism.AreIsomorphic(UnderlyingGraph(generators.MatrixDirectedCycle(5)), c5) {
+		t.Errorf("Expected the underlying graph of a directed cycle to be a cycle")
+	}
+
+	if _, err := Power(c5, 0); err != InvalidPower {
+		t.Errorf("Expected %v, got %v", InvalidPower, err)
+	}
+	if _, err := InducedSubgraph(c5, []int{0, 0}); err != RepeatedVertex {
+		t.Errorf("Expected %v, got %v", RepeatedVertex, err)
+	}
+	if _, err := InducedSubgraph(c5, []int{5}); err != InvalidVertex {
+		t.Errorf("Expected %v, got %v", InvalidVertex, err)
+	}
+	if _, err := ContractEdge(c5, 0, 2); err != NotAnEdge {
+		t.Errorf("Expected %v, got %v", NotAnEdge, err)
+	}
+	if _, err := IdentifyVertices(c5, 1, 1); err != SameVertices {
+		t.Errorf("Expected %v, got %v", SameVertices, err)
+	}
+}